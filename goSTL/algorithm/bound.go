@@ -135,3 +135,68 @@ func lowerBound(begin, end *iterator.Iterator, e interface{}, cmp comparator.Com
 	}
 	return l
 }
+
+//@title    EqualRange
+//@description
+//		对传入的开启和结尾的两个迭代器中的有序元素查找与待查找元素相等的区间
+//		元素是否相等完全由比较器决定,比较结果为0即视为相等
+//		返回的区间为左闭右开区间[lo,hi),hi-lo即为相等元素的个数
+//		若该元素不存在,则lo与hi相等,均指向该元素应插入的位置
+//		若未传入比较器且并非默认类型,则lo与hi均返回-1
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待查找的起始迭代器
+//@param    	end			*iterator.Iterator			待查找的末尾迭代器
+//@param    	e			interface{}					待查找元素
+//@param    	Cmp			...comparator.Comparator	比较器
+//@return    	lo 			int							首个等于该元素的下标
+//@return    	hi 			int							最后一个等于该元素的下标的后一位
+func EqualRange(begin, end *iterator.Iterator, e interface{}, Cmp ...comparator.Comparator) (lo, hi int) {
+	//判断比较器是否有效
+	var cmp comparator.Comparator
+	cmp = nil
+	if len(Cmp) == 0 {
+		cmp = comparator.GetCmp(e)
+	} else {
+		cmp = Cmp[0]
+	}
+	if cmp == nil {
+		return -1, -1
+	}
+	l, r := begin.Index(), end.Index()
+	if l < 0 || l > r {
+		//区间为空
+		return l, l
+	}
+	//分别寻找首个不小于该元素和首个大于该元素的位置
+	lo = partitionBound(begin, l, r+1, func(v interface{}) bool {
+		return cmp(v, e) < 0
+	})
+	hi = partitionBound(begin, lo, r+1, func(v interface{}) bool {
+		return cmp(v, e) <= 0
+	})
+	return lo, hi
+}
+
+//@title    partitionBound
+//@description
+//		在左闭右开区间[l,r)内以二分的方式寻找首个不满足条件的元素下标
+//		要求区间内满足条件的元素全部位于不满足条件的元素之前
+//		若全部满足则返回r
+//@receiver		nil
+//@param    	it			*iterator.Iterator			用于访问元素的迭代器
+//@param    	l			int							区间起始下标
+//@param    	r			int							区间末尾下标的后一位
+//@param    	pred		func(interface{}) bool		判断条件
+//@return    	idx 		int							首个不满足条件的元素下标
+func partitionBound(it *iterator.Iterator, l, r int, pred func(v interface{}) bool) (idx int) {
+	m := 0
+	for l < r {
+		m = (l + r) / 2
+		if pred(it.Get(m).Value()) {
+			l = m + 1
+		} else {
+			r = m
+		}
+	}
+	return l
+}
@@ -0,0 +1,82 @@
+package algorithm
+
+import (
+	"testing"
+
+	"github.com/hlccd/goSTL/utils/iterator"
+)
+
+type record struct {
+	key int
+	tag string
+}
+
+func recordCmp(a, b interface{}) int {
+	x, y := a.(record).key, b.(record).key
+	if x < y {
+		return -1
+	}
+	if x > y {
+		return 1
+	}
+	return 0
+}
+
+//按key有序,key为3和7的元素重复出现,tag用于区分相等的元素
+func records() []interface{} {
+	return []interface{}{
+		record{1, "a"},
+		record{3, "b"}, record{3, "c"}, record{3, "d"},
+		record{5, "e"},
+		record{7, "f"}, record{7, "g"},
+		record{9, "h"},
+	}
+}
+
+func TestBound(t *testing.T) {
+	tests := []struct {
+		name         string
+		key          int
+		lower, upper int
+		lo, hi       int
+	}{
+		{"absent before first", 0, 0, 0, 0, 0},
+		{"first", 1, 0, 0, 0, 1},
+		{"duplicate run", 3, 1, 3, 1, 4},
+		{"absent inside", 4, 4, 3, 4, 4},
+		{"single in middle", 5, 4, 4, 4, 5},
+		{"duplicate run before last", 7, 5, 6, 5, 7},
+		{"last", 9, 7, 7, 7, 8},
+		{"absent after last", 10, 7, 7, 8, 8},
+	}
+	i := iterator.New(records())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := record{key: tt.key}
+			if got := LowerBound(i.Begin(), i.End(), e, recordCmp); got != tt.lower {
+				t.Errorf("LowerBound = %d, want %d", got, tt.lower)
+			}
+			if got := UpperBound(i.Begin(), i.End(), e, recordCmp); got != tt.upper {
+				t.Errorf("UpperBound = %d, want %d", got, tt.upper)
+			}
+			lo, hi := EqualRange(i.Begin(), i.End(), e, recordCmp)
+			if lo != tt.lo || hi != tt.hi {
+				t.Errorf("EqualRange = [%d,%d), want [%d,%d)", lo, hi, tt.lo, tt.hi)
+			}
+		})
+	}
+}
+
+func TestEqualRangeNoComparator(t *testing.T) {
+	i := iterator.New(records())
+	if lo, hi := EqualRange(i.Begin(), i.End(), record{key: 3}); lo != -1 || hi != -1 {
+		t.Errorf("EqualRange = [%d,%d), want [-1,-1)", lo, hi)
+	}
+}
+
+func TestEqualRangeEmpty(t *testing.T) {
+	i := iterator.New(make([]interface{}, 0, 0))
+	if lo, hi := EqualRange(i.Begin(), i.End(), record{key: 3}, recordCmp); hi != lo {
+		t.Errorf("EqualRange = [%d,%d), want an empty range", lo, hi)
+	}
+}
@@ -0,0 +1,190 @@
+package algorithm
+
+//@Title		algorithm
+//@Description
+//		算法包
+//		该部分通过传入迭代器将其间的元素作为二叉堆进行操作
+//		与STL一致,堆顶为比较器意义下最大的元素,故SortHeap的结果为升序
+//		堆中下标为p的元素的子节点下标为2p+1和2p+2,下标均相对于起始迭代器计算
+//		若未传入比较器且并非默认类型则不进行任何操作
+import (
+	"github.com/hlccd/goSTL/utils/comparator"
+	"github.com/hlccd/goSTL/utils/iterator"
+)
+
+//@title    MakeHeap
+//@description
+//		将传入的两个迭代器之间的元素构建为堆
+//		自最后一个非叶子节点起依次向前对每个节点进行下沉(Floyd建堆法)
+//		时间复杂度为O(n)
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			起始迭代器
+//@param    	end			*iterator.Iterator			末尾迭代器
+//@param    	Cmp			...comparator.Comparator	比较器
+//@return    	nil
+func MakeHeap(begin, end *iterator.Iterator, Cmp ...comparator.Comparator) {
+	l, r := begin.Index(), end.Index()
+	if l < 0 || l >= r {
+		return
+	}
+	//判断比较器是否有效
+	var cmp comparator.Comparator
+	cmp = nil
+	if len(Cmp) > 0 {
+		cmp = Cmp[0]
+	} else {
+		cmp = comparator.GetCmp(begin.Value())
+	}
+	if cmp == nil {
+		return
+	}
+	for p := l + (r-l-1)/2; p >= l; p-- {
+		heapDown(begin, l, p, r, cmp)
+	}
+}
+
+//@title    PushHeap
+//@description
+//		起始迭代器到末尾迭代器前一位之间的元素已是堆,将末尾迭代器处的元素加入该堆
+//		对末尾元素进行上升即可,时间复杂度为O(log n)
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			起始迭代器
+//@param    	end			*iterator.Iterator			末尾迭代器,指向新加入的元素
+//@param    	Cmp			...comparator.Comparator	比较器
+//@return    	nil
+func PushHeap(begin, end *iterator.Iterator, Cmp ...comparator.Comparator) {
+	l, r := begin.Index(), end.Index()
+	if l < 0 || l >= r {
+		return
+	}
+	//判断比较器是否有效
+	var cmp comparator.Comparator
+	cmp = nil
+	if len(Cmp) > 0 {
+		cmp = Cmp[0]
+	} else {
+		cmp = comparator.GetCmp(begin.Value())
+	}
+	if cmp == nil {
+		return
+	}
+	heapUp(begin, l, r, cmp)
+}
+
+//@title    PopHeap
+//@description
+//		将堆顶元素与末尾迭代器处的元素交换,并使起始迭代器到末尾迭代器前一位之间的元素重新成为堆
+//		交换后对新的堆顶进行下沉即可,时间复杂度为O(log n)
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			起始迭代器
+//@param    	end			*iterator.Iterator			末尾迭代器
+//@param    	Cmp			...comparator.Comparator	比较器
+//@return    	nil
+func PopHeap(begin, end *iterator.Iterator, Cmp ...comparator.Comparator) {
+	l, r := begin.Index(), end.Index()
+	if l < 0 || l >= r {
+		return
+	}
+	//判断比较器是否有效
+	var cmp comparator.Comparator
+	cmp = nil
+	if len(Cmp) > 0 {
+		cmp = Cmp[0]
+	} else {
+		cmp = comparator.GetCmp(begin.Value())
+	}
+	if cmp == nil {
+		return
+	}
+	swap(begin, l, r)
+	heapDown(begin, l, l, r-1, cmp)
+}
+
+//@title    SortHeap
+//@description
+//		将已是堆的两个迭代器之间的元素排序
+//		依次将堆顶弹出至堆的末尾,排序结果为升序
+//		时间复杂度为O(n log n)
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			起始迭代器
+//@param    	end			*iterator.Iterator			末尾迭代器
+//@param    	Cmp			...comparator.Comparator	比较器
+//@return    	nil
+func SortHeap(begin, end *iterator.Iterator, Cmp ...comparator.Comparator) {
+	l, r := begin.Index(), end.Index()
+	if l < 0 || l >= r {
+		return
+	}
+	//判断比较器是否有效
+	var cmp comparator.Comparator
+	cmp = nil
+	if len(Cmp) > 0 {
+		cmp = Cmp[0]
+	} else {
+		cmp = comparator.GetCmp(begin.Value())
+	}
+	if cmp == nil {
+		return
+	}
+	for ; r > l; r-- {
+		swap(begin, l, r)
+		heapDown(begin, l, l, r-1, cmp)
+	}
+}
+
+//@title    heapUp
+//@description
+//		对以下标l为堆顶的堆中下标为p的元素进行上升
+//		当其大于父节点时与父节点交换,直至到达堆顶
+//@receiver		nil
+//@param    	it			*iterator.Iterator			用于访问元素的迭代器
+//@param    	l			int							堆顶的下标
+//@param    	p			int							待上升元素的下标
+//@param    	cmp			comparator.Comparator		比较器
+//@return    	nil
+func heapUp(it *iterator.Iterator, l, p int, cmp comparator.Comparator) {
+	e := it.Get(p).Value()
+	for p > l {
+		q := l + (p-l-1)/2
+		v := it.Get(q).Value()
+		if cmp(v, e) >= 0 {
+			break
+		}
+		it.Get(p).Set(v)
+		p = q
+	}
+	it.Get(p).Set(e)
+}
+
+//@title    heapDown
+//@description
+//		对以下标l为堆顶、下标r为堆尾的堆中下标为p的元素进行下沉
+//		当其小于较大的子节点时与该子节点交换,直至成为叶子节点
+//@receiver		nil
+//@param    	it			*iterator.Iterator			用于访问元素的迭代器
+//@param    	l			int							堆顶的下标
+//@param    	p			int							待下沉元素的下标
+//@param    	r			int							堆尾的下标
+//@param    	cmp			comparator.Comparator		比较器
+//@return    	nil
+func heapDown(it *iterator.Iterator, l, p, r int, cmp comparator.Comparator) {
+	e := it.Get(p).Value()
+	for {
+		q := l + 2*(p-l) + 1
+		if q > r {
+			break
+		}
+		v := it.Get(q).Value()
+		if q+1 <= r {
+			if w := it.Get(q + 1).Value(); cmp(w, v) > 0 {
+				q, v = q+1, w
+			}
+		}
+		if cmp(e, v) >= 0 {
+			break
+		}
+		it.Get(p).Set(v)
+		p = q
+	}
+	it.Get(p).Set(e)
+}
@@ -0,0 +1,369 @@
+package algorithm
+
+//@Title		algorithm
+//@Description
+//		算法包
+//		该部分通过传入迭代器对元素集合进行原地修改
+//		包括逆序、旋转、去重、删除、替换、划分、打乱、填充等
+//		对于会删除元素的算法,被删除的元素并不会真正从容器中移除
+//		而是将保留的元素依次前移,并返回最后一个保留元素的下标作为新的逻辑末尾
+//		若没有元素被保留,则返回的下标为起始下标的前一位
+import (
+	"github.com/hlccd/goSTL/utils/comparator"
+	"github.com/hlccd/goSTL/utils/iterator"
+	"math/rand"
+	"time"
+)
+
+//@title    Reverse
+//@description
+//		将传入的开启和结尾的两个迭代器中的元素顺序逆转
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待逆转的起始迭代器
+//@param    	end			*iterator.Iterator			待逆转的末尾迭代器
+//@return    	nil
+func Reverse(begin, end *iterator.Iterator) {
+	//判断末尾迭代器是否在起始迭代器前方
+	gap := end.Index() - begin.Index()
+	if gap <= 0 {
+		return
+	}
+	reverse(begin, begin.Index(), end.Index())
+}
+
+//@title    Rotate
+//@description
+//		将传入的开启和结尾的两个迭代器中的元素进行旋转
+//		使得中间迭代器所指元素成为新的首元素,其前方元素依次移至末尾
+//		通过三次逆序实现
+//		返回原首元素旋转后所在的下标
+//		若中间迭代器不在区间内则不做处理并返回-1
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待旋转的起始迭代器
+//@param    	middle		*iterator.Iterator			旋转后成为首元素的迭代器
+//@param    	end			*iterator.Iterator			待旋转的末尾迭代器
+//@return    	idx			int							原首元素的新下标
+func Rotate(begin, middle, end *iterator.Iterator) (idx int) {
+	l, m, r := begin.Index(), middle.Index(), end.Index()
+	if l < 0 || m < l || m > r {
+		return -1
+	}
+	if m == l {
+		return l
+	}
+	reverse(begin, l, m-1)
+	reverse(begin, m, r)
+	reverse(begin, l, r)
+	return l + r - m + 1
+}
+
+//@title    Unique
+//@description
+//		将传入的开启和结尾的两个迭代器中连续相等的元素仅保留第一个
+//		元素是否相等由比较器决定
+//		保留的元素依次前移,返回最后一个保留元素的下标
+//		若未传入比较器且并非默认类型则不做处理并返回末尾下标
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待去重的起始迭代器
+//@param    	end			*iterator.Iterator			待去重的末尾迭代器
+//@param    	Cmp			...comparator.Comparator	比较器
+//@return    	idx			int							新的末尾下标
+func Unique(begin, end *iterator.Iterator, Cmp ...comparator.Comparator) (idx int) {
+	l, r := begin.Index(), end.Index()
+	if l < 0 || l >= r {
+		return r
+	}
+	//判断比较器是否有效
+	var cmp comparator.Comparator
+	cmp = nil
+	if len(Cmp) > 0 {
+		cmp = Cmp[0]
+	} else {
+		cmp = comparator.GetCmp(begin.Value())
+	}
+	if cmp == nil {
+		return r
+	}
+	//idx指向最后一个保留的元素
+	idx = l
+	for i := l + 1; i <= r; i++ {
+		e := begin.Get(i).Value()
+		if cmp(begin.Get(idx).Value(), e) != 0 {
+			idx++
+			begin.Get(idx).Set(e)
+		}
+	}
+	return idx
+}
+
+//@title    RemoveIf
+//@description
+//		将传入的开启和结尾的两个迭代器中满足条件的元素删除
+//		不满足条件的元素保持原有顺序依次前移
+//		返回最后一个保留元素的下标
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待删除的起始迭代器
+//@param    	end			*iterator.Iterator			待删除的末尾迭代器
+//@param    	pred		func(interface{}) bool		删除条件
+//@return    	idx			int							新的末尾下标
+func RemoveIf(begin, end *iterator.Iterator, pred func(e interface{}) bool) (idx int) {
+	l, r := begin.Index(), end.Index()
+	if l < 0 || l > r {
+		return r
+	}
+	idx = l - 1
+	for i := l; i <= r; i++ {
+		e := begin.Get(i).Value()
+		if !pred(e) {
+			idx++
+			begin.Get(idx).Set(e)
+		}
+	}
+	return idx
+}
+
+//@title    Replace
+//@description
+//		将传入的开启和结尾的两个迭代器中与oldValue相等的元素替换为newValue
+//		元素是否相等由比较器决定
+//		返回被替换的元素个数
+//		若未传入比较器且并非默认类型则不做处理并返回0
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待替换的起始迭代器
+//@param    	end			*iterator.Iterator			待替换的末尾迭代器
+//@param    	oldValue	interface{}					被替换的元素
+//@param    	newValue	interface{}					替换后的元素
+//@param    	Cmp			...comparator.Comparator	比较器
+//@return    	num			int							被替换的元素个数
+func Replace(begin, end *iterator.Iterator, oldValue, newValue interface{}, Cmp ...comparator.Comparator) (num int) {
+	//判断比较器是否有效
+	var cmp comparator.Comparator
+	cmp = nil
+	if len(Cmp) > 0 {
+		cmp = Cmp[0]
+	} else {
+		cmp = comparator.GetCmp(oldValue)
+	}
+	if cmp == nil {
+		return 0
+	}
+	return ReplaceIf(begin, end, func(e interface{}) bool {
+		return cmp(e, oldValue) == 0
+	}, newValue)
+}
+
+//@title    ReplaceIf
+//@description
+//		将传入的开启和结尾的两个迭代器中满足条件的元素替换为newValue
+//		返回被替换的元素个数
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待替换的起始迭代器
+//@param    	end			*iterator.Iterator			待替换的末尾迭代器
+//@param    	pred		func(interface{}) bool		替换条件
+//@param    	newValue	interface{}					替换后的元素
+//@return    	num			int							被替换的元素个数
+func ReplaceIf(begin, end *iterator.Iterator, pred func(e interface{}) bool, newValue interface{}) (num int) {
+	l, r := begin.Index(), end.Index()
+	if l < 0 {
+		return 0
+	}
+	for i := l; i <= r; i++ {
+		if pred(begin.Get(i).Value()) {
+			begin.Get(i).Set(newValue)
+			num++
+		}
+	}
+	return num
+}
+
+//@title    Partition
+//@description
+//		将传入的开启和结尾的两个迭代器中满足条件的元素移至不满足条件的元素之前
+//		不保证两部分内部的相对顺序
+//		返回首个不满足条件的元素下标,若全部满足则为末尾下标的后一位
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待划分的起始迭代器
+//@param    	end			*iterator.Iterator			待划分的末尾迭代器
+//@param    	pred		func(interface{}) bool		划分条件
+//@return    	idx			int							划分点下标
+func Partition(begin, end *iterator.Iterator, pred func(e interface{}) bool) (idx int) {
+	l, r := begin.Index(), end.Index()
+	if l < 0 || l > r {
+		return l
+	}
+	//双指针从两端向中间靠拢,遇到位置不对的元素时交换
+	for l <= r {
+		for l <= r && pred(begin.Get(l).Value()) {
+			l++
+		}
+		for l <= r && !pred(begin.Get(r).Value()) {
+			r--
+		}
+		if l < r {
+			swap(begin, l, r)
+			l++
+			r--
+		}
+	}
+	return l
+}
+
+//@title    StablePartition
+//@description
+//		将传入的开启和结尾的两个迭代器中满足条件的元素移至不满足条件的元素之前
+//		两部分内部均保持原有的相对顺序
+//		借助额外的切片实现
+//		返回首个不满足条件的元素下标,若全部满足则为末尾下标的后一位
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待划分的起始迭代器
+//@param    	end			*iterator.Iterator			待划分的末尾迭代器
+//@param    	pred		func(interface{}) bool		划分条件
+//@return    	idx			int							划分点下标
+func StablePartition(begin, end *iterator.Iterator, pred func(e interface{}) bool) (idx int) {
+	l, r := begin.Index(), end.Index()
+	if l < 0 || l > r {
+		return l
+	}
+	//满足条件的元素直接前移,不满足条件的元素暂存后放回末尾
+	idx = l
+	tmp := make([]interface{}, 0, 0)
+	for i := l; i <= r; i++ {
+		e := begin.Get(i).Value()
+		if pred(e) {
+			begin.Get(idx).Set(e)
+			idx++
+		} else {
+			tmp = append(tmp, e)
+		}
+	}
+	for i, j := idx, 0; j < len(tmp); i, j = i+1, j+1 {
+		begin.Get(i).Set(tmp[j])
+	}
+	return idx
+}
+
+//@title    PartitionPoint
+//@description
+//		对已经按条件划分好的传入的开启和结尾的两个迭代器中的元素进行二分查找
+//		返回首个不满足条件的元素下标,若全部满足则为末尾下标的后一位
+//		warning:仅对满足条件的元素全部位于不满足条件的元素之前的集合有效
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			已划分的起始迭代器
+//@param    	end			*iterator.Iterator			已划分的末尾迭代器
+//@param    	pred		func(interface{}) bool		划分条件
+//@return    	idx			int							划分点下标
+func PartitionPoint(begin, end *iterator.Iterator, pred func(e interface{}) bool) (idx int) {
+	l, r := begin.Index(), end.Index()
+	if l < 0 || l > r {
+		return l
+	}
+	return partitionBound(begin, l, r+1, pred)
+}
+
+//@title    Shuffle
+//@description
+//		将传入的开启和结尾的两个迭代器中的元素随机打乱
+//		使用Fisher-Yates洗牌算法,每种排列出现的概率相同
+//		可传入随机数生成器以便复现结果,若不传入则以当前时间为种子新建一个
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待打乱的起始迭代器
+//@param    	end			*iterator.Iterator			待打乱的末尾迭代器
+//@param    	rands		...*rand.Rand				随机数生成器
+//@return    	nil
+func Shuffle(begin, end *iterator.Iterator, rands ...*rand.Rand) {
+	//判断末尾迭代器是否在起始迭代器前方
+	l, r := begin.Index(), end.Index()
+	if r-l <= 0 {
+		return
+	}
+	var rd *rand.Rand
+	if len(rands) > 0 && rands[0] != nil {
+		rd = rands[0]
+	} else {
+		rd = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	for i := r; i > l; i-- {
+		swap(begin, i, l+rd.Intn(i-l+1))
+	}
+}
+
+//@title    Fill
+//@description
+//		将传入的开启和结尾的两个迭代器中的元素全部设为e
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待填充的起始迭代器
+//@param    	end			*iterator.Iterator			待填充的末尾迭代器
+//@param    	e			interface{}					填充元素
+//@return    	nil
+func Fill(begin, end *iterator.Iterator, e interface{}) {
+	l, r := begin.Index(), end.Index()
+	if l < 0 {
+		return
+	}
+	for i := l; i <= r; i++ {
+		begin.Get(i).Set(e)
+	}
+}
+
+//@title    Iota
+//@description
+//		将传入的开启和结尾的两个迭代器中的元素依次设为e,e+1,e+2...
+//		仅支持系统自带的整数和浮点数类型,填充元素类型与e相同
+//		若e并非上述类型则不做处理并返回false
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待填充的起始迭代器
+//@param    	end			*iterator.Iterator			待填充的末尾迭代器
+//@param    	e			interface{}					起始元素
+//@return    	b			bool						填充成功?
+func Iota(begin, end *iterator.Iterator, e interface{}) (b bool) {
+	if _, ok := increase(e); !ok {
+		return false
+	}
+	l, r := begin.Index(), end.Index()
+	if l < 0 {
+		return true
+	}
+	for i := l; i <= r; i++ {
+		begin.Get(i).Set(e)
+		e, _ = increase(e)
+	}
+	return true
+}
+
+//@title    increase
+//@description
+//		返回系统自带的整数或浮点数类型元素加一后的值
+//		若并非上述类型则返回nil和false
+//@receiver		nil
+//@param    	e			interface{}					待自增元素
+//@return    	ans			interface{}					自增后的元素
+//@return    	b			bool						该类型可自增?
+func increase(e interface{}) (ans interface{}, b bool) {
+	switch v := e.(type) {
+	case int:
+		return v + 1, true
+	case int8:
+		return v + 1, true
+	case uint8:
+		return v + 1, true
+	case int16:
+		return v + 1, true
+	case uint16:
+		return v + 1, true
+	case int32:
+		return v + 1, true
+	case uint32:
+		return v + 1, true
+	case int64:
+		return v + 1, true
+	case uint64:
+		return v + 1, true
+	case uint:
+		return v + 1, true
+	case float32:
+		return v + 1, true
+	case float64:
+		return v + 1, true
+	}
+	return nil, false
+}
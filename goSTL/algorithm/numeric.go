@@ -0,0 +1,337 @@
+package algorithm
+
+//@Title		algorithm
+//@Description
+//		算法包
+//		该部分通过传入迭代器和运算函数对元素集合进行累积计算和变换
+//		若未传入运算函数,则对系统自带的整数、浮点数、复数类型使用加法和乘法
+//		对于字符串类型默认的加法即为拼接
+//		写入结果的算法从目标迭代器当前下标开始依次写入,超出目标元素集合范围的部分将被丢弃
+import (
+	"github.com/hlccd/goSTL/utils/iterator"
+)
+
+//@title    Accumulate
+//@description
+//		以init为初始值,从左到右依次将传入的开启和结尾的两个迭代器中的元素累积到结果中
+//		即ans=op(...op(op(init,e0),e1)...,en)
+//		若未传入运算函数则使用默认加法,类型不支持时返回nil
+//@receiver		nil
+//@param    	begin		*iterator.Iterator							待累积的起始迭代器
+//@param    	end			*iterator.Iterator							待累积的末尾迭代器
+//@param    	init		interface{}									初始值
+//@param    	ops			...func(a, b interface{}) interface{}		累积函数
+//@return    	ans			interface{}									累积结果
+func Accumulate(begin, end *iterator.Iterator, init interface{}, ops ...func(a, b interface{}) interface{}) (ans interface{}) {
+	op := plus
+	if len(ops) > 0 {
+		op = ops[0]
+	}
+	ans = init
+	l, r := begin.Index(), end.Index()
+	if l < 0 {
+		return ans
+	}
+	for i := l; i <= r; i++ {
+		ans = op(ans, begin.Get(i).Value())
+	}
+	return ans
+}
+
+//@title    Reduce
+//@description
+//		以首个元素为初始值,从左到右依次将后续元素累积到结果中
+//		若未传入运算函数则使用默认加法,类型不支持时返回nil
+//		区间为空时返回nil
+//@receiver		nil
+//@param    	begin		*iterator.Iterator							待累积的起始迭代器
+//@param    	end			*iterator.Iterator							待累积的末尾迭代器
+//@param    	ops			...func(a, b interface{}) interface{}		累积函数
+//@return    	ans			interface{}									累积结果
+func Reduce(begin, end *iterator.Iterator, ops ...func(a, b interface{}) interface{}) (ans interface{}) {
+	l, r := begin.Index(), end.Index()
+	if l < 0 || l > r {
+		return nil
+	}
+	init := begin.Get(l).Value()
+	if l == r {
+		return init
+	}
+	return Accumulate(begin.Get(l+1), end.Get(r), init, ops...)
+}
+
+//@title    InnerProduct
+//@description
+//		以init为初始值,计算两组元素的内积
+//		第二组元素从begin2开始,与第一组元素一一对应,第二组元素不足时计算提前结束
+//		可传入两个运算函数,第一个用于累加,第二个用于对应元素相乘
+//		未传入的运算函数使用默认加法和乘法,类型不支持时返回nil
+//@receiver		nil
+//@param    	begin1		*iterator.Iterator							第一组元素的起始迭代器
+//@param    	end1		*iterator.Iterator							第一组元素的末尾迭代器
+//@param    	begin2		*iterator.Iterator							第二组元素的起始迭代器
+//@param    	init		interface{}									初始值
+//@param    	ops			...func(a, b interface{}) interface{}		累加函数和相乘函数
+//@return    	ans			interface{}									内积结果
+func InnerProduct(begin1, end1, begin2 *iterator.Iterator, init interface{}, ops ...func(a, b interface{}) interface{}) (ans interface{}) {
+	add, mul := plus, multiply
+	if len(ops) > 0 {
+		add = ops[0]
+	}
+	if len(ops) > 1 {
+		mul = ops[1]
+	}
+	ans = init
+	l1, r1, l2 := begin1.Index(), end1.Index(), begin2.Index()
+	if l1 < 0 || l2 < 0 {
+		return ans
+	}
+	r2 := begin2.End().Index()
+	for ; l1 <= r1 && l2 <= r2; l1, l2 = l1+1, l2+1 {
+		ans = add(ans, mul(begin1.Get(l1).Value(), begin2.Get(l2).Value()))
+	}
+	return ans
+}
+
+//@title    PartialSum
+//@description
+//		计算传入的开启和结尾的两个迭代器中元素的前缀和并依次写入目标迭代器
+//		目标迭代器的第i位写入前i+1个元素的累积结果
+//		目标迭代器可与起始迭代器相同,此时原地计算
+//		若未传入运算函数则使用默认加法
+//		返回最后写入的元素在目标迭代器中的下标,未写入任何元素时返回-1
+//@receiver		nil
+//@param    	begin		*iterator.Iterator							待计算的起始迭代器
+//@param    	end			*iterator.Iterator							待计算的末尾迭代器
+//@param    	dest		*iterator.Iterator							写入结果的目标迭代器
+//@param    	ops			...func(a, b interface{}) interface{}		累积函数
+//@return    	idx			int											最后写入的下标
+func PartialSum(begin, end, dest *iterator.Iterator, ops ...func(a, b interface{}) interface{}) (idx int) {
+	return scan(begin, end, dest, nil, false, ops...)
+}
+
+//@title    InclusiveScan
+//@description
+//		以init为初始值,计算传入的开启和结尾的两个迭代器中元素的包含式前缀扫描并依次写入目标迭代器
+//		目标迭代器的第i位写入init与前i+1个元素的累积结果
+//		目标迭代器可与起始迭代器相同,此时原地计算
+//		若未传入运算函数则使用默认加法
+//		返回最后写入的元素在目标迭代器中的下标,未写入任何元素时返回-1
+//@receiver		nil
+//@param    	begin		*iterator.Iterator							待计算的起始迭代器
+//@param    	end			*iterator.Iterator							待计算的末尾迭代器
+//@param    	dest		*iterator.Iterator							写入结果的目标迭代器
+//@param    	init		interface{}									初始值
+//@param    	ops			...func(a, b interface{}) interface{}		累积函数
+//@return    	idx			int											最后写入的下标
+func InclusiveScan(begin, end, dest *iterator.Iterator, init interface{}, ops ...func(a, b interface{}) interface{}) (idx int) {
+	return scan(begin, end, dest, init, true, ops...)
+}
+
+//@title    scan
+//@description
+//		计算前缀累积结果并依次写入目标迭代器
+//		hasInit为true时以init为初始值,否则以首个元素为初始值
+//		先读取当前元素再写入,故目标迭代器与起始迭代器相同时也能得到正确结果
+//@receiver		nil
+//@param    	begin		*iterator.Iterator							待计算的起始迭代器
+//@param    	end			*iterator.Iterator							待计算的末尾迭代器
+//@param    	dest		*iterator.Iterator							写入结果的目标迭代器
+//@param    	init		interface{}									初始值
+//@param    	hasInit		bool										是否使用初始值
+//@param    	ops			...func(a, b interface{}) interface{}		累积函数
+//@return    	idx			int											最后写入的下标
+func scan(begin, end, dest *iterator.Iterator, init interface{}, hasInit bool, ops ...func(a, b interface{}) interface{}) (idx int) {
+	op := plus
+	if len(ops) > 0 {
+		op = ops[0]
+	}
+	l, r, d := begin.Index(), end.Index(), dest.Index()
+	if l < 0 || l > r || d < 0 {
+		return -1
+	}
+	dr := dest.End().Index()
+	ans := init
+	for idx = d - 1; l <= r && idx < dr; l++ {
+		e := begin.Get(l).Value()
+		if hasInit {
+			ans = op(ans, e)
+		} else {
+			ans, hasInit = e, true
+		}
+		idx++
+		dest.Get(idx).Set(ans)
+	}
+	return idx
+}
+
+//@title    Transform
+//@description
+//		对传入的开启和结尾的两个迭代器中的元素依次调用变换函数并将结果写入目标迭代器
+//		目标迭代器可与起始迭代器相同,此时原地变换
+//		返回最后写入的元素在目标迭代器中的下标,未写入任何元素时返回-1
+//@receiver		nil
+//@param    	begin		*iterator.Iterator							待变换的起始迭代器
+//@param    	end			*iterator.Iterator							待变换的末尾迭代器
+//@param    	dest		*iterator.Iterator							写入结果的目标迭代器
+//@param    	fn			func(e interface{}) interface{}				变换函数
+//@return    	idx			int											最后写入的下标
+func Transform(begin, end, dest *iterator.Iterator, fn func(e interface{}) interface{}) (idx int) {
+	l, r, d := begin.Index(), end.Index(), dest.Index()
+	if l < 0 || l > r || d < 0 {
+		return -1
+	}
+	dr := dest.End().Index()
+	for idx = d - 1; l <= r && idx < dr; l++ {
+		e := fn(begin.Get(l).Value())
+		idx++
+		dest.Get(idx).Set(e)
+	}
+	return idx
+}
+
+//@title    plus
+//@description
+//		默认加法
+//		对相同的系统自带整数、浮点数、复数类型进行相加,对字符串进行拼接
+//		类型不同或不支持时返回nil
+//@receiver		nil
+//@param    	a			interface{}					加数
+//@param    	b			interface{}					加数
+//@return    	ans			interface{}					相加结果
+func plus(a, b interface{}) (ans interface{}) {
+	switch x := a.(type) {
+	case int:
+		if y, ok := b.(int); ok {
+			return x + y
+		}
+	case int8:
+		if y, ok := b.(int8); ok {
+			return x + y
+		}
+	case uint8:
+		if y, ok := b.(uint8); ok {
+			return x + y
+		}
+	case int16:
+		if y, ok := b.(int16); ok {
+			return x + y
+		}
+	case uint16:
+		if y, ok := b.(uint16); ok {
+			return x + y
+		}
+	case int32:
+		if y, ok := b.(int32); ok {
+			return x + y
+		}
+	case uint32:
+		if y, ok := b.(uint32); ok {
+			return x + y
+		}
+	case int64:
+		if y, ok := b.(int64); ok {
+			return x + y
+		}
+	case uint64:
+		if y, ok := b.(uint64); ok {
+			return x + y
+		}
+	case uint:
+		if y, ok := b.(uint); ok {
+			return x + y
+		}
+	case float32:
+		if y, ok := b.(float32); ok {
+			return x + y
+		}
+	case float64:
+		if y, ok := b.(float64); ok {
+			return x + y
+		}
+	case complex64:
+		if y, ok := b.(complex64); ok {
+			return x + y
+		}
+	case complex128:
+		if y, ok := b.(complex128); ok {
+			return x + y
+		}
+	case string:
+		if y, ok := b.(string); ok {
+			return x + y
+		}
+	}
+	return nil
+}
+
+//@title    multiply
+//@description
+//		默认乘法
+//		对相同的系统自带整数、浮点数、复数类型进行相乘
+//		类型不同或不支持时返回nil
+//@receiver		nil
+//@param    	a			interface{}					乘数
+//@param    	b			interface{}					乘数
+//@return    	ans			interface{}					相乘结果
+func multiply(a, b interface{}) (ans interface{}) {
+	switch x := a.(type) {
+	case int:
+		if y, ok := b.(int); ok {
+			return x * y
+		}
+	case int8:
+		if y, ok := b.(int8); ok {
+			return x * y
+		}
+	case uint8:
+		if y, ok := b.(uint8); ok {
+			return x * y
+		}
+	case int16:
+		if y, ok := b.(int16); ok {
+			return x * y
+		}
+	case uint16:
+		if y, ok := b.(uint16); ok {
+			return x * y
+		}
+	case int32:
+		if y, ok := b.(int32); ok {
+			return x * y
+		}
+	case uint32:
+		if y, ok := b.(uint32); ok {
+			return x * y
+		}
+	case int64:
+		if y, ok := b.(int64); ok {
+			return x * y
+		}
+	case uint64:
+		if y, ok := b.(uint64); ok {
+			return x * y
+		}
+	case uint:
+		if y, ok := b.(uint); ok {
+			return x * y
+		}
+	case float32:
+		if y, ok := b.(float32); ok {
+			return x * y
+		}
+	case float64:
+		if y, ok := b.(float64); ok {
+			return x * y
+		}
+	case complex64:
+		if y, ok := b.(complex64); ok {
+			return x * y
+		}
+	case complex128:
+		if y, ok := b.(complex128); ok {
+			return x * y
+		}
+	}
+	return nil
+}
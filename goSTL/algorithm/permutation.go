@@ -0,0 +1,346 @@
+package algorithm
+
+//@Title		algorithm
+//@Description
+//		算法包
+//		该部分通过传入迭代器和比较器对元素集合进行排列组合
+//		可求下一个或上一个字典序排列,相等元素不会产生重复排列
+//		可判断两个元素集合是否互为排列
+//		也可按需逐个生成元素集合的k组合以及全部子集
+import (
+	"github.com/hlccd/goSTL/utils/comparator"
+	"github.com/hlccd/goSTL/utils/iterator"
+)
+
+//combination组合生成器结构体
+//包含待组合的元素集合和当前组合在元素集合中的下标
+//每次调用Next时才生成下一个组合,不会一次性生成全部组合
+//当isPower为true时将依次生成大小为0到n的全部组合,即生成全部子集
+type combination struct {
+	data    []interface{} //待组合的元素集合
+	idx     []int         //当前组合中各元素在元素集合中的下标
+	k       int           //当前组合的大小
+	isPower bool          //是否生成全部子集
+	started bool          //是否已经生成过组合
+	done    bool          //是否已经生成完毕
+}
+
+//@title    NextPermutation
+//@description
+//		将传入的开启和结尾的两个迭代器中的元素重排为按比较器确定的字典序中的下一个排列
+//		若存在下一个排列则返回true
+//		若当前已是最后一个排列,则将其重排为第一个排列(即升序)并返回false
+//		相等元素之间不做区分,故存在重复元素时不会产生重复的排列
+//		若未传入比较器且并非默认类型则不做处理并返回false
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待排列的起始迭代器
+//@param    	end			*iterator.Iterator			待排列的末尾迭代器
+//@param    	Cmp			...comparator.Comparator	比较器
+//@return    	b			bool						存在下一个排列?
+func NextPermutation(begin, end *iterator.Iterator, Cmp ...comparator.Comparator) (b bool) {
+	//判断末尾迭代器是否在起始迭代器前方
+	gap := end.Index() - begin.Index()
+	if gap <= 0 {
+		return false
+	}
+	//判断比较器是否有效
+	var cmp comparator.Comparator
+	cmp = nil
+	if len(Cmp) > 0 {
+		cmp = Cmp[0]
+	} else {
+		cmp = comparator.GetCmp(begin.Value())
+	}
+	if cmp == nil {
+		return false
+	}
+	return nextPermutation(begin, begin.Index(), end.Index(), cmp)
+}
+
+//@title    PrevPermutation
+//@description
+//		将传入的开启和结尾的两个迭代器中的元素重排为按比较器确定的字典序中的上一个排列
+//		若存在上一个排列则返回true
+//		若当前已是第一个排列,则将其重排为最后一个排列(即降序)并返回false
+//		相等元素之间不做区分,故存在重复元素时不会产生重复的排列
+//		若未传入比较器且并非默认类型则不做处理并返回false
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待排列的起始迭代器
+//@param    	end			*iterator.Iterator			待排列的末尾迭代器
+//@param    	Cmp			...comparator.Comparator	比较器
+//@return    	b			bool						存在上一个排列?
+func PrevPermutation(begin, end *iterator.Iterator, Cmp ...comparator.Comparator) (b bool) {
+	//判断末尾迭代器是否在起始迭代器前方
+	gap := end.Index() - begin.Index()
+	if gap <= 0 {
+		return false
+	}
+	//判断比较器是否有效
+	var cmp comparator.Comparator
+	cmp = nil
+	if len(Cmp) > 0 {
+		cmp = Cmp[0]
+	} else {
+		cmp = comparator.GetCmp(begin.Value())
+	}
+	if cmp == nil {
+		return false
+	}
+	//将比较器取反后求下一个排列即为求上一个排列
+	return nextPermutation(begin, begin.Index(), end.Index(), func(a, b interface{}) int {
+		return cmp(b, a)
+	})
+}
+
+//@title    nextPermutation
+//@description
+//		求下标l到r之间元素的下一个排列
+//		从后向前寻找首个比后一位小的元素i
+//		再从后向前寻找首个比元素i大的元素j,交换两者后将i之后的部分逆序即可
+//		若不存在元素i,说明当前已是最后一个排列,将整体逆序即为第一个排列
+//@receiver		nil
+//@param    	it			*iterator.Iterator			用于访问元素的迭代器
+//@param    	l			int							起始下标
+//@param    	r			int							末尾下标
+//@param    	cmp			comparator.Comparator		比较器
+//@return    	b			bool						存在下一个排列?
+func nextPermutation(it *iterator.Iterator, l, r int, cmp comparator.Comparator) (b bool) {
+	i := r - 1
+	for i >= l && cmp(it.Get(i).Value(), it.Get(i+1).Value()) >= 0 {
+		i--
+	}
+	if i < l {
+		//当前已是最后一个排列
+		reverse(it, l, r)
+		it.Get(l)
+		return false
+	}
+	j := r
+	for cmp(it.Get(j).Value(), it.Get(i).Value()) <= 0 {
+		j--
+	}
+	swap(it, i, j)
+	reverse(it, i+1, r)
+	//将迭代器移回起始位置,以便调用者循环复用同一组迭代器
+	it.Get(l)
+	return true
+}
+
+//@title    IsPermutation
+//@description
+//		判断两组迭代器中的元素集合是否互为排列
+//		即两者元素个数相同且每个元素出现的次数相同
+//		元素是否相等完全由比较器决定
+//		复制两组元素并排序后逐一比较,不会修改原元素集合
+//		若未传入比较器且并非默认类型则返回false
+//@receiver		nil
+//@param    	begin1		*iterator.Iterator			第一组元素的起始迭代器
+//@param    	end1		*iterator.Iterator			第一组元素的末尾迭代器
+//@param    	begin2		*iterator.Iterator			第二组元素的起始迭代器
+//@param    	end2		*iterator.Iterator			第二组元素的末尾迭代器
+//@param    	Cmp			...comparator.Comparator	比较器
+//@return    	b			bool						互为排列?
+func IsPermutation(begin1, end1, begin2, end2 *iterator.Iterator, Cmp ...comparator.Comparator) (b bool) {
+	a, c := collect(begin1, end1), collect(begin2, end2)
+	if len(a) != len(c) {
+		return false
+	}
+	if len(a) == 0 {
+		return true
+	}
+	//判断比较器是否有效
+	var cmp comparator.Comparator
+	cmp = nil
+	if len(Cmp) > 0 {
+		cmp = Cmp[0]
+	} else {
+		cmp = comparator.GetCmp(a[0])
+	}
+	if cmp == nil {
+		return false
+	}
+	//排序后逐一比较
+	ai, ci := iterator.New(a), iterator.New(c)
+	Sort(ai.Begin(), ai.End(), cmp)
+	Sort(ci.Begin(), ci.End(), cmp)
+	for i := 0; i < len(a); i++ {
+		if cmp(a[i], c[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+//@title    Combinations
+//@description
+//		新建一个组合生成器并返回
+//		该生成器将按字典序逐个生成传入的两个迭代器中的元素集合的全部k组合
+//		组合按元素所在位置区分,相等元素位于不同位置时视为不同元素
+//		生成器创建时复制了元素集合,后续对原元素集合的修改不会影响生成结果
+//		k小于0或大于元素个数时不生成任何组合
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			元素集合的起始迭代器
+//@param    	end			*iterator.Iterator			元素集合的末尾迭代器
+//@param    	k			int							组合大小
+//@return    	c			*combination				新建的组合生成器指针
+func Combinations(begin, end *iterator.Iterator, k int) (c *combination) {
+	data := collect(begin, end)
+	return &combination{
+		data:    data,
+		idx:     make([]int, 0, 0),
+		k:       k,
+		isPower: false,
+		started: false,
+		done:    k < 0 || k > len(data),
+	}
+}
+
+//@title    PowerSet
+//@description
+//		新建一个子集生成器并返回
+//		该生成器将逐个生成传入的两个迭代器中的元素集合的全部子集
+//		先生成空集,随后按子集大小从小到大、同大小按字典序依次生成
+//		生成器创建时复制了元素集合,后续对原元素集合的修改不会影响生成结果
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			元素集合的起始迭代器
+//@param    	end			*iterator.Iterator			元素集合的末尾迭代器
+//@return    	c			*combination				新建的子集生成器指针
+func PowerSet(begin, end *iterator.Iterator) (c *combination) {
+	return &combination{
+		data:    collect(begin, end),
+		idx:     make([]int, 0, 0),
+		k:       0,
+		isPower: true,
+		started: false,
+		done:    false,
+	}
+}
+
+//@title    Next
+//@description
+//		以combination组合生成器做接收者
+//		生成下一个组合,生成成功返回true
+//		若全部组合都已生成完毕则返回false
+//		首次调用时生成第一个组合
+//@receiver		c			*combination			接受者combination的指针
+//@param    	nil
+//@return    	b			bool					生成成功?
+func (c *combination) Next() (b bool) {
+	if c == nil || c.done {
+		return false
+	}
+	if !c.started {
+		//首次调用,生成第一个组合
+		c.started = true
+		c.first()
+		return true
+	}
+	n := len(c.data)
+	//从后向前寻找可以后移的下标
+	i := c.k - 1
+	for i >= 0 && c.idx[i] == n-c.k+i {
+		i--
+	}
+	if i >= 0 {
+		//后移该下标并将其后的下标依次紧随其后
+		c.idx[i]++
+		for j := i + 1; j < c.k; j++ {
+			c.idx[j] = c.idx[j-1] + 1
+		}
+		return true
+	}
+	//当前大小的组合已全部生成
+	if c.isPower && c.k < n {
+		c.k++
+		c.first()
+		return true
+	}
+	c.done = true
+	return false
+}
+
+//@title    first
+//@description
+//		以combination组合生成器做接收者
+//		将当前组合设为当前大小下的第一个组合,即前k个元素
+//@receiver		c			*combination			接受者combination的指针
+//@param    	nil
+//@return    	nil
+func (c *combination) first() {
+	c.idx = c.idx[0:0]
+	for i := 0; i < c.k; i++ {
+		c.idx = append(c.idx, i)
+	}
+}
+
+//@title    Value
+//@description
+//		以combination组合生成器做接收者
+//		返回当前组合中的元素,元素顺序与其在原元素集合中的顺序一致
+//		每次返回的都是新切片,可自由修改
+//		若尚未调用Next或已生成完毕则返回nil
+//@receiver		c			*combination			接受者combination的指针
+//@param    	nil
+//@return    	es			[]interface{}			当前组合中的元素
+func (c *combination) Value() (es []interface{}) {
+	if c == nil || !c.started || c.done {
+		return nil
+	}
+	es = make([]interface{}, 0, len(c.idx))
+	for _, p := range c.idx {
+		es = append(es, c.data[p])
+	}
+	return es
+}
+
+//@title    collect
+//@description
+//		将传入的开启和结尾的两个迭代器之间的元素复制到新切片中并返回
+//		复制结束后起始迭代器仍指向原位置
+//		若区间为空则返回空切片
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			起始迭代器
+//@param    	end			*iterator.Iterator			末尾迭代器
+//@return    	es			[]interface{}				复制得到的元素集合
+func collect(begin, end *iterator.Iterator) (es []interface{}) {
+	l, r := begin.Index(), end.Index()
+	es = make([]interface{}, 0, 0)
+	if l < 0 || l > r {
+		return es
+	}
+	for i := l; i <= r; i++ {
+		es = append(es, begin.Get(i).Value())
+	}
+	//Get会移动迭代器,复制结束后将起始迭代器移回原位
+	begin.Get(l)
+	return es
+}
+
+//@title    swap
+//@description
+//		交换迭代器中下标i和下标j的两个元素
+//@receiver		nil
+//@param    	it			*iterator.Iterator			用于访问元素的迭代器
+//@param    	i			int							待交换元素下标
+//@param    	j			int							待交换元素下标
+//@return    	nil
+func swap(it *iterator.Iterator, i, j int) {
+	ti := it.Get(i).Value()
+	tj := it.Get(j).Value()
+	it.Get(i).Set(tj)
+	it.Get(j).Set(ti)
+}
+
+//@title    reverse
+//@description
+//		将迭代器中下标l到r之间的元素顺序逆转
+//@receiver		nil
+//@param    	it			*iterator.Iterator			用于访问元素的迭代器
+//@param    	l			int							起始下标
+//@param    	r			int							末尾下标
+//@return    	nil
+func reverse(it *iterator.Iterator, l, r int) {
+	for ; l < r; l, r = l+1, r-1 {
+		swap(it, l, r)
+	}
+}
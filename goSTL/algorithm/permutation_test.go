@@ -0,0 +1,59 @@
+package algorithm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hlccd/goSTL/utils/iterator"
+)
+
+func TestPermutationLoop(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  []interface{}
+		count int
+	}{
+		{"distinct", []interface{}{1, 2, 3}, 6},
+		{"four distinct", []interface{}{1, 2, 3, 4}, 24},
+		{"duplicates", []interface{}{1, 1, 2}, 3},
+		{"two pairs", []interface{}{1, 1, 2, 2}, 6},
+		{"all equal", []interface{}{5, 5, 5}, 1},
+		{"single", []interface{}{7}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			//升序开始,循环复用同一组迭代器求下一个排列
+			data := append([]interface{}{}, tt.data...)
+			i := iterator.New(data)
+			b, e := i.Begin(), i.End()
+			seen := map[string]bool{fmt.Sprint(data): true}
+			last := fmt.Sprint(data)
+			for NextPermutation(b, e) {
+				s := fmt.Sprint(data)
+				if seen[s] {
+					t.Fatalf("permutation %s repeated", s)
+				}
+				seen[s] = true
+				if s <= last {
+					t.Fatalf("%s does not follow %s", s, last)
+				}
+				last = s
+			}
+			if len(seen) != tt.count {
+				t.Fatalf("NextPermutation yielded %d permutations, want %d", len(seen), tt.count)
+			}
+			if fmt.Sprint(data) != fmt.Sprint(tt.data) {
+				t.Fatalf("after the last permutation got %v, want %v", data, tt.data)
+			}
+			//从降序开始,循环复用同一组迭代器求上一个排列
+			PrevPermutation(b, e)
+			n := 1
+			for PrevPermutation(b, e) {
+				n++
+			}
+			if n != tt.count {
+				t.Fatalf("PrevPermutation yielded %d permutations, want %d", n, tt.count)
+			}
+		})
+	}
+}
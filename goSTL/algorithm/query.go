@@ -0,0 +1,337 @@
+package algorithm
+
+//@Title		algorithm
+//@Description
+//		算法包
+//		该部分通过传入迭代器和判断条件或比较器对元素集合进行线性查询
+//		不会修改元素集合
+//		查找类算法返回所找元素的下标,不存在时返回-1
+import (
+	"github.com/hlccd/goSTL/utils/comparator"
+	"github.com/hlccd/goSTL/utils/iterator"
+)
+
+//@title    FindIf
+//@description
+//		在传入的开启和结尾的两个迭代器中顺序查找首个满足条件的元素
+//		找到后返回该元素的下标,不存在则返回-1
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待查找的起始迭代器
+//@param    	end			*iterator.Iterator			待查找的末尾迭代器
+//@param    	pred		func(interface{}) bool		查找条件
+//@return    	idx			int							首个满足条件的元素下标
+func FindIf(begin, end *iterator.Iterator, pred func(e interface{}) bool) (idx int) {
+	l, r := begin.Index(), end.Index()
+	if l < 0 {
+		return -1
+	}
+	for i := l; i <= r; i++ {
+		if pred(begin.Get(i).Value()) {
+			return i
+		}
+	}
+	return -1
+}
+
+//@title    FindIfNot
+//@description
+//		在传入的开启和结尾的两个迭代器中顺序查找首个不满足条件的元素
+//		找到后返回该元素的下标,不存在则返回-1
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待查找的起始迭代器
+//@param    	end			*iterator.Iterator			待查找的末尾迭代器
+//@param    	pred		func(interface{}) bool		查找条件
+//@return    	idx			int							首个不满足条件的元素下标
+func FindIfNot(begin, end *iterator.Iterator, pred func(e interface{}) bool) (idx int) {
+	return FindIf(begin, end, func(e interface{}) bool {
+		return !pred(e)
+	})
+}
+
+//@title    CountIf
+//@description
+//		统计传入的开启和结尾的两个迭代器中满足条件的元素个数
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待统计的起始迭代器
+//@param    	end			*iterator.Iterator			待统计的末尾迭代器
+//@param    	pred		func(interface{}) bool		统计条件
+//@return    	num			int							满足条件的元素个数
+func CountIf(begin, end *iterator.Iterator, pred func(e interface{}) bool) (num int) {
+	l, r := begin.Index(), end.Index()
+	if l < 0 {
+		return 0
+	}
+	for i := l; i <= r; i++ {
+		if pred(begin.Get(i).Value()) {
+			num++
+		}
+	}
+	return num
+}
+
+//@title    AllOf
+//@description
+//		判断传入的开启和结尾的两个迭代器中是否全部元素都满足条件
+//		区间为空时返回true
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待判断的起始迭代器
+//@param    	end			*iterator.Iterator			待判断的末尾迭代器
+//@param    	pred		func(interface{}) bool		判断条件
+//@return    	b			bool						全部满足?
+func AllOf(begin, end *iterator.Iterator, pred func(e interface{}) bool) (b bool) {
+	return FindIfNot(begin, end, pred) == -1
+}
+
+//@title    AnyOf
+//@description
+//		判断传入的开启和结尾的两个迭代器中是否存在满足条件的元素
+//		区间为空时返回false
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待判断的起始迭代器
+//@param    	end			*iterator.Iterator			待判断的末尾迭代器
+//@param    	pred		func(interface{}) bool		判断条件
+//@return    	b			bool						存在满足的元素?
+func AnyOf(begin, end *iterator.Iterator, pred func(e interface{}) bool) (b bool) {
+	return FindIf(begin, end, pred) != -1
+}
+
+//@title    NoneOf
+//@description
+//		判断传入的开启和结尾的两个迭代器中是否全部元素都不满足条件
+//		区间为空时返回true
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待判断的起始迭代器
+//@param    	end			*iterator.Iterator			待判断的末尾迭代器
+//@param    	pred		func(interface{}) bool		判断条件
+//@return    	b			bool						全部不满足?
+func NoneOf(begin, end *iterator.Iterator, pred func(e interface{}) bool) (b bool) {
+	return FindIf(begin, end, pred) == -1
+}
+
+//@title    MinElement
+//@description
+//		查找传入的开启和结尾的两个迭代器中的最小元素
+//		存在多个最小元素时返回第一个的下标
+//		区间为空或未传入比较器且并非默认类型时返回-1
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待查找的起始迭代器
+//@param    	end			*iterator.Iterator			待查找的末尾迭代器
+//@param    	Cmp			...comparator.Comparator	比较器
+//@return    	idx			int							最小元素下标
+func MinElement(begin, end *iterator.Iterator, Cmp ...comparator.Comparator) (idx int) {
+	idx, _ = MinMaxElement(begin, end, Cmp...)
+	return idx
+}
+
+//@title    MaxElement
+//@description
+//		查找传入的开启和结尾的两个迭代器中的最大元素
+//		存在多个最大元素时返回第一个的下标
+//		区间为空或未传入比较器且并非默认类型时返回-1
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待查找的起始迭代器
+//@param    	end			*iterator.Iterator			待查找的末尾迭代器
+//@param    	Cmp			...comparator.Comparator	比较器
+//@return    	idx			int							最大元素下标
+func MaxElement(begin, end *iterator.Iterator, Cmp ...comparator.Comparator) (idx int) {
+	l, r := begin.Index(), end.Index()
+	if l < 0 || l > r {
+		return -1
+	}
+	//判断比较器是否有效
+	var cmp comparator.Comparator
+	cmp = nil
+	if len(Cmp) > 0 {
+		cmp = Cmp[0]
+	} else {
+		cmp = comparator.GetCmp(begin.Value())
+	}
+	if cmp == nil {
+		return -1
+	}
+	idx = l
+	m := begin.Get(l).Value()
+	for i := l + 1; i <= r; i++ {
+		if e := begin.Get(i).Value(); cmp(e, m) > 0 {
+			idx, m = i, e
+		}
+	}
+	return idx
+}
+
+//@title    MinMaxElement
+//@description
+//		同时查找传入的开启和结尾的两个迭代器中的最小元素和最大元素
+//		存在多个最小元素时返回第一个的下标,存在多个最大元素时返回最后一个的下标
+//		区间为空或未传入比较器且并非默认类型时均返回-1
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待查找的起始迭代器
+//@param    	end			*iterator.Iterator			待查找的末尾迭代器
+//@param    	Cmp			...comparator.Comparator	比较器
+//@return    	minIdx		int							最小元素下标
+//@return    	maxIdx		int							最大元素下标
+func MinMaxElement(begin, end *iterator.Iterator, Cmp ...comparator.Comparator) (minIdx, maxIdx int) {
+	l, r := begin.Index(), end.Index()
+	if l < 0 || l > r {
+		return -1, -1
+	}
+	//判断比较器是否有效
+	var cmp comparator.Comparator
+	cmp = nil
+	if len(Cmp) > 0 {
+		cmp = Cmp[0]
+	} else {
+		cmp = comparator.GetCmp(begin.Value())
+	}
+	if cmp == nil {
+		return -1, -1
+	}
+	minIdx, maxIdx = l, l
+	mi, ma := begin.Get(l).Value(), begin.Get(l).Value()
+	for i := l + 1; i <= r; i++ {
+		e := begin.Get(i).Value()
+		if cmp(e, mi) < 0 {
+			minIdx, mi = i, e
+		}
+		if cmp(e, ma) >= 0 {
+			maxIdx, ma = i, e
+		}
+	}
+	return minIdx, maxIdx
+}
+
+//@title    AdjacentFind
+//@description
+//		在传入的开启和结尾的两个迭代器中查找首对相邻且相等的元素
+//		元素是否相等由比较器决定
+//		找到后返回该对元素中前一个的下标,不存在则返回-1
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待查找的起始迭代器
+//@param    	end			*iterator.Iterator			待查找的末尾迭代器
+//@param    	Cmp			...comparator.Comparator	比较器
+//@return    	idx			int							首对相等元素中前一个的下标
+func AdjacentFind(begin, end *iterator.Iterator, Cmp ...comparator.Comparator) (idx int) {
+	l, r := begin.Index(), end.Index()
+	if l < 0 || l >= r {
+		return -1
+	}
+	//判断比较器是否有效
+	var cmp comparator.Comparator
+	cmp = nil
+	if len(Cmp) > 0 {
+		cmp = Cmp[0]
+	} else {
+		cmp = comparator.GetCmp(begin.Value())
+	}
+	if cmp == nil {
+		return -1
+	}
+	for i := l; i < r; i++ {
+		if cmp(begin.Get(i).Value(), begin.Get(i+1).Value()) == 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+//@title    Mismatch
+//@description
+//		同时顺序遍历两组迭代器中的元素,查找首个不相等的位置
+//		元素是否相等由比较器决定
+//		返回该位置在两组元素中各自的下标
+//		若较短一组的全部元素均与另一组对应元素相等,则返回比较结束后各自的下一位下标
+//		未传入比较器且并非默认类型时均返回-1
+//@receiver		nil
+//@param    	begin1		*iterator.Iterator			第一组元素的起始迭代器
+//@param    	end1		*iterator.Iterator			第一组元素的末尾迭代器
+//@param    	begin2		*iterator.Iterator			第二组元素的起始迭代器
+//@param    	end2		*iterator.Iterator			第二组元素的末尾迭代器
+//@param    	Cmp			...comparator.Comparator	比较器
+//@return    	idx1		int							第一组元素中不相等的下标
+//@return    	idx2		int							第二组元素中不相等的下标
+func Mismatch(begin1, end1, begin2, end2 *iterator.Iterator, Cmp ...comparator.Comparator) (idx1, idx2 int) {
+	l1, r1, l2, r2 := begin1.Index(), end1.Index(), begin2.Index(), end2.Index()
+	if l1 < 0 || l2 < 0 {
+		return l1, l2
+	}
+	//判断比较器是否有效
+	var cmp comparator.Comparator
+	cmp = nil
+	if len(Cmp) > 0 {
+		cmp = Cmp[0]
+	} else {
+		cmp = comparator.GetCmp(begin1.Value())
+	}
+	if cmp == nil {
+		return -1, -1
+	}
+	for l1 <= r1 && l2 <= r2 {
+		if cmp(begin1.Get(l1).Value(), begin2.Get(l2).Value()) != 0 {
+			break
+		}
+		l1++
+		l2++
+	}
+	return l1, l2
+}
+
+//@title    Equal
+//@description
+//		判断两组迭代器中的元素是否逐一相等
+//		元素是否相等由比较器决定,两组元素个数不同时必然不相等
+//		未传入比较器且并非默认类型时返回false
+//@receiver		nil
+//@param    	begin1		*iterator.Iterator			第一组元素的起始迭代器
+//@param    	end1		*iterator.Iterator			第一组元素的末尾迭代器
+//@param    	begin2		*iterator.Iterator			第二组元素的起始迭代器
+//@param    	end2		*iterator.Iterator			第二组元素的末尾迭代器
+//@param    	Cmp			...comparator.Comparator	比较器
+//@return    	b			bool						相等?
+func Equal(begin1, end1, begin2, end2 *iterator.Iterator, Cmp ...comparator.Comparator) (b bool) {
+	return LexicographicalCompare(begin1, end1, begin2, end2, Cmp...) == 0
+}
+
+//@title    LexicographicalCompare
+//@description
+//		按字典序比较两组迭代器中的元素
+//		逐一比较对应元素,首个不相等的元素决定大小
+//		若较短一组是另一组的前缀,则较短一组更小
+//		返回值与比较器一致:第一组更小返回-1,相等返回0,第一组更大返回1
+//		未传入比较器且并非默认类型时返回-2
+//@receiver		nil
+//@param    	begin1		*iterator.Iterator			第一组元素的起始迭代器
+//@param    	end1		*iterator.Iterator			第一组元素的末尾迭代器
+//@param    	begin2		*iterator.Iterator			第二组元素的起始迭代器
+//@param    	end2		*iterator.Iterator			第二组元素的末尾迭代器
+//@param    	Cmp			...comparator.Comparator	比较器
+//@return    	num			int							比较结果
+func LexicographicalCompare(begin1, end1, begin2, end2 *iterator.Iterator, Cmp ...comparator.Comparator) (num int) {
+	a, c := collect(begin1, end1), collect(begin2, end2)
+	if len(a) > 0 && len(c) > 0 {
+		//判断比较器是否有效
+		var cmp comparator.Comparator
+		cmp = nil
+		if len(Cmp) > 0 {
+			cmp = Cmp[0]
+		} else {
+			cmp = comparator.GetCmp(a[0])
+		}
+		if cmp == nil {
+			return -2
+		}
+		for i := 0; i < len(a) && i < len(c); i++ {
+			if num = cmp(a[i], c[i]); num != 0 {
+				if num > 0 {
+					return 1
+				}
+				return -1
+			}
+		}
+	}
+	if len(a) < len(c) {
+		return -1
+	} else if len(a) > len(c) {
+		return 1
+	}
+	return 0
+}
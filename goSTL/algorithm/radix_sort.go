@@ -0,0 +1,346 @@
+package algorithm
+
+//@Title		algorithm
+//@Description
+//		算法包
+//		该部分通过传入迭代器进行非比较排序,排序结果与默认比较器的升序一致
+//		整数和浮点数类型通过位变换转化为保序的无符号整数后进行LSD基数排序
+//		字符串类型与默认比较器一致,先按长度分组,再在组内按字节进行MSD基数排序
+//		小范围的整数类型可使用计数排序
+//		当元素类型并非上述类型或类型不统一时,退化为使用比较器的Sort
+//		基数排序和计数排序都是稳定的
+import (
+	"github.com/hlccd/goSTL/utils/comparator"
+	"github.com/hlccd/goSTL/utils/iterator"
+	"math"
+)
+
+//@title    RadixSort
+//@description
+//		对传入的开启和结尾的两个迭代器中的值进行基数排序,结果为升序
+//		若传入了比较器,则说明需按自定义顺序排序,直接使用Sort进行比较排序
+//		若元素为系统自带的整数、浮点数或字符串类型且类型统一则进行基数排序
+//		否则退化为使用默认比较器的Sort
+//		排序结束后起始迭代器仍指向原位置,以便在退化时和多次调用时复用
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待排序的起始迭代器
+//@param    	end			*iterator.Iterator			待排序的末尾迭代器
+//@param    	Cmp			...comparator.Comparator	比较器
+//@return    	nil
+func RadixSort(begin, end *iterator.Iterator, Cmp ...comparator.Comparator) {
+	//获取两个迭代器之间的差值,若末尾迭代器不在起始迭代器后方则终止
+	gap := end.Index() - begin.Index()
+	if gap <= 0 {
+		return
+	}
+	l := begin.Index()
+	if len(Cmp) > 0 {
+		Sort(begin, end, Cmp...)
+		begin.Get(l)
+		return
+	}
+	es := collect(begin, end)
+	if keys, ok := radixKeys(es); ok {
+		lsdSort(keys, es)
+	} else if ss, ok := stringKeys(es); ok {
+		stringSort(ss, es)
+	} else {
+		Sort(begin, end)
+		begin.Get(l)
+		return
+	}
+	//将排序结果放回迭代器中
+	for i := range es {
+		begin.Get(l + i).Set(es[i])
+	}
+	begin.Get(l)
+}
+
+//@title    CountingSort
+//@description
+//		对传入的开启和结尾的两个迭代器中的值进行计数排序,结果为升序
+//		仅在元素为类型统一的系统自带整数类型且取值范围较小时使用计数排序
+//		取值范围不超过元素个数的两倍或不超过2^16时视为较小
+//		否则退化为RadixSort
+//		排序结束后起始迭代器仍指向原位置,以便在退化时和多次调用时复用
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待排序的起始迭代器
+//@param    	end			*iterator.Iterator			待排序的末尾迭代器
+//@param    	Cmp			...comparator.Comparator	比较器
+//@return    	nil
+func CountingSort(begin, end *iterator.Iterator, Cmp ...comparator.Comparator) {
+	//获取两个迭代器之间的差值,若末尾迭代器不在起始迭代器后方则终止
+	gap := end.Index() - begin.Index()
+	if gap <= 0 {
+		return
+	}
+	l := begin.Index()
+	if len(Cmp) > 0 {
+		Sort(begin, end, Cmp...)
+		begin.Get(l)
+		return
+	}
+	es := collect(begin, end)
+	keys, ok := radixKeys(es)
+	if !ok || isFloat(es[0]) {
+		RadixSort(begin, end)
+		return
+	}
+	//确定取值范围
+	mi, ma := keys[0], keys[0]
+	for _, k := range keys {
+		if k < mi {
+			mi = k
+		}
+		if k > ma {
+			ma = k
+		}
+	}
+	limit := uint64(2 * len(es))
+	if limit < 65536 {
+		limit = 65536
+	}
+	if ma-mi >= limit {
+		RadixSort(begin, end)
+		return
+	}
+	//统计每个值出现的次数并求前缀和以确定每个值的起始位置
+	cnt := make([]int, ma-mi+2)
+	for _, k := range keys {
+		cnt[k-mi+1]++
+	}
+	for i := 1; i < len(cnt); i++ {
+		cnt[i] += cnt[i-1]
+	}
+	tmp := make([]interface{}, len(es))
+	for i, k := range keys {
+		tmp[cnt[k-mi]] = es[i]
+		cnt[k-mi]++
+	}
+	//将排序结果放回迭代器中
+	for i := range tmp {
+		begin.Get(l + i).Set(tmp[i])
+	}
+	begin.Get(l)
+}
+
+//@title    radixKeys
+//@description
+//		将整数或浮点数类型的元素集合转化为保序的无符号整数键值
+//		有符号整数翻转符号位,负浮点数全部取反,非负浮点数翻转符号位
+//		若元素并非上述类型或类型不统一则返回false
+//@receiver		nil
+//@param    	es			[]interface{}				元素集合
+//@return    	keys		[]uint64					对应的键值
+//@return    	ok			bool						转化成功?
+func radixKeys(es []interface{}) (keys []uint64, ok bool) {
+	const sign = uint64(1) << 63
+	keys = make([]uint64, len(es))
+	for i, e := range es {
+		switch v := e.(type) {
+		case int:
+			_, ok = es[0].(int)
+			keys[i] = uint64(v) ^ sign
+		case int8:
+			_, ok = es[0].(int8)
+			keys[i] = uint64(v) ^ sign
+		case int16:
+			_, ok = es[0].(int16)
+			keys[i] = uint64(v) ^ sign
+		case int32:
+			_, ok = es[0].(int32)
+			keys[i] = uint64(v) ^ sign
+		case int64:
+			_, ok = es[0].(int64)
+			keys[i] = uint64(v) ^ sign
+		case uint:
+			_, ok = es[0].(uint)
+			keys[i] = uint64(v)
+		case uint8:
+			_, ok = es[0].(uint8)
+			keys[i] = uint64(v)
+		case uint16:
+			_, ok = es[0].(uint16)
+			keys[i] = uint64(v)
+		case uint32:
+			_, ok = es[0].(uint32)
+			keys[i] = uint64(v)
+		case uint64:
+			_, ok = es[0].(uint64)
+			keys[i] = v
+		case float32:
+			_, ok = es[0].(float32)
+			keys[i] = floatKey(float64(v))
+		case float64:
+			_, ok = es[0].(float64)
+			keys[i] = floatKey(v)
+		default:
+			ok = false
+		}
+		if !ok {
+			return nil, false
+		}
+	}
+	return keys, true
+}
+
+//@title    floatKey
+//@description
+//		将浮点数转化为保序的无符号整数
+//@receiver		nil
+//@param    	f			float64						浮点数
+//@return    	k			uint64						对应的键值
+func floatKey(f float64) (k uint64) {
+	const sign = uint64(1) << 63
+	k = math.Float64bits(f)
+	if k&sign != 0 {
+		return ^k
+	}
+	return k | sign
+}
+
+//@title    isFloat
+//@description
+//		判断元素是否为浮点数类型
+//@receiver		nil
+//@param    	e			interface{}					元素
+//@return    	b			bool						是浮点数?
+func isFloat(e interface{}) (b bool) {
+	switch e.(type) {
+	case float32, float64:
+		return true
+	}
+	return false
+}
+
+//@title    lsdSort
+//@description
+//		以8位为一轮,从低位到高位对键值进行LSD基数排序,元素随键值一同移动
+//		若某一轮中全部键值的该字节相同则跳过该轮
+//@receiver		nil
+//@param    	keys		[]uint64					键值
+//@param    	es			[]interface{}				元素集合
+//@return    	nil
+func lsdSort(keys []uint64, es []interface{}) {
+	n := len(keys)
+	tk, te := make([]uint64, n), make([]interface{}, n)
+	for shift := uint(0); shift < 64; shift += 8 {
+		var cnt [257]int
+		for _, k := range keys {
+			cnt[(k>>shift)&0xff+1]++
+		}
+		if cnt[(keys[0]>>shift)&0xff+1] == n {
+			//该字节全部相同,无需排序
+			continue
+		}
+		for i := 1; i < 257; i++ {
+			cnt[i] += cnt[i-1]
+		}
+		for i, k := range keys {
+			b := (k >> shift) & 0xff
+			tk[cnt[b]], te[cnt[b]] = k, es[i]
+			cnt[b]++
+		}
+		keys, tk = tk, keys
+		copy(es, te)
+	}
+}
+
+//@title    stringKeys
+//@description
+//		将元素集合转化为字符串集合
+//		若元素并非全部为字符串类型则返回false
+//@receiver		nil
+//@param    	es			[]interface{}				元素集合
+//@return    	ss			[]string					对应的字符串集合
+//@return    	ok			bool						转化成功?
+func stringKeys(es []interface{}) (ss []string, ok bool) {
+	ss = make([]string, len(es))
+	for i, e := range es {
+		if ss[i], ok = e.(string); !ok {
+			return nil, false
+		}
+	}
+	return ss, true
+}
+
+//@title    stringSort
+//@description
+//		对字符串进行排序,顺序与默认的字符串比较器一致
+//		先按长度进行计数排序,再对每组等长字符串进行MSD基数排序
+//@receiver		nil
+//@param    	ss			[]string					字符串集合
+//@param    	es			[]interface{}				元素集合,排序后写回
+//@return    	nil
+func stringSort(ss []string, es []interface{}) {
+	//按长度进行计数排序
+	ma := 0
+	for _, s := range ss {
+		if len(s) > ma {
+			ma = len(s)
+		}
+	}
+	cnt := make([]int, ma+2)
+	for _, s := range ss {
+		cnt[len(s)+1]++
+	}
+	for i := 1; i < len(cnt); i++ {
+		cnt[i] += cnt[i-1]
+	}
+	starts := append([]int{}, cnt...)
+	tmp := make([]string, len(ss))
+	for _, s := range ss {
+		tmp[cnt[len(s)]] = s
+		cnt[len(s)]++
+	}
+	//对每组等长字符串进行MSD基数排序
+	aux := make([]string, len(ss))
+	for i := 0; i <= ma; i++ {
+		msdSort(tmp[starts[i]:starts[i+1]], aux[starts[i]:starts[i+1]], 0)
+	}
+	for i := range tmp {
+		es[i] = tmp[i]
+	}
+}
+
+//@title    msdSort
+//@description
+//		对等长字符串从第d个字节开始进行MSD基数排序
+//		按第d个字节分桶后对每个桶递归处理下一个字节
+//		元素较少时改用插入排序
+//@receiver		nil
+//@param    	ss			[]string					等长字符串集合
+//@param    	aux			[]string					与ss等长的辅助空间
+//@param    	d			int							当前处理的字节位置
+//@return    	nil
+func msdSort(ss, aux []string, d int) {
+	if len(ss) <= 1 || d >= len(ss[0]) {
+		return
+	}
+	if len(ss) <= 16 {
+		//元素较少时使用插入排序
+		for i := 1; i < len(ss); i++ {
+			for j := i; j > 0 && ss[j][d:] < ss[j-1][d:]; j-- {
+				ss[j], ss[j-1] = ss[j-1], ss[j]
+			}
+		}
+		return
+	}
+	var cnt [257]int
+	for _, s := range ss {
+		cnt[int(s[d])+1]++
+	}
+	for i := 1; i < 257; i++ {
+		cnt[i] += cnt[i-1]
+	}
+	var starts [257]int
+	copy(starts[:], cnt[:])
+	for _, s := range ss {
+		aux[cnt[s[d]]] = s
+		cnt[s[d]]++
+	}
+	copy(ss, aux)
+	for b := 0; b < 256; b++ {
+		msdSort(ss[starts[b]:starts[b+1]], aux[starts[b]:starts[b+1]], d+1)
+	}
+}
@@ -0,0 +1,107 @@
+package algorithm
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hlccd/goSTL/utils/comparator"
+	"github.com/hlccd/goSTL/utils/iterator"
+)
+
+//以sort包的结果作为参照,less为对应类型的升序
+func sortCases() []struct {
+	name string
+	data []interface{}
+	less func(a, b interface{}) bool
+} {
+	r := rand.New(rand.NewSource(1))
+	ints, wide, floats, strs := make([]interface{}, 500), make([]interface{}, 500), make([]interface{}, 500), make([]interface{}, 500)
+	bools, complexes, uints := make([]interface{}, 500), make([]interface{}, 500), make([]interface{}, 500)
+	letters := "abc"
+	for i := 0; i < 500; i++ {
+		ints[i] = r.Intn(100) - 50
+		wide[i] = r.Int63() - r.Int63()
+		floats[i] = r.NormFloat64() * 1e6
+		b := make([]byte, r.Intn(4))
+		for j := range b {
+			b[j] = letters[r.Intn(len(letters))]
+		}
+		strs[i] = string(b)
+		bools[i] = r.Intn(2) == 1
+		complexes[i] = complex(float64(r.Intn(10)), float64(r.Intn(10)))
+		uints[i] = uint32(r.Uint32())
+	}
+	byCmp := func(e interface{}) func(a, b interface{}) bool {
+		cmp := comparator.GetCmp(e)
+		return func(a, b interface{}) bool { return cmp(a, b) < 0 }
+	}
+	return []struct {
+		name string
+		data []interface{}
+		less func(a, b interface{}) bool
+	}{
+		{"small int range", ints, byCmp(ints[0])},
+		{"wide int64 range", wide, byCmp(wide[0])},
+		{"float64", floats, byCmp(floats[0])},
+		{"uint32", uints, byCmp(uints[0])},
+		{"string", strs, byCmp(strs[0])},
+		{"bool", bools, byCmp(bools[0])},
+		{"complex128", complexes, byCmp(complexes[0])},
+	}
+}
+
+func TestRadixAndCountingSort(t *testing.T) {
+	sorts := []struct {
+		name string
+		fn   func(begin, end *iterator.Iterator, Cmp ...comparator.Comparator)
+	}{
+		{"RadixSort", RadixSort},
+		{"CountingSort", CountingSort},
+	}
+	for _, s := range sorts {
+		for _, tt := range sortCases() {
+			t.Run(s.name+"/"+tt.name, func(t *testing.T) {
+				want := append([]interface{}{}, tt.data...)
+				sort.SliceStable(want, func(i, j int) bool { return tt.less(want[i], want[j]) })
+				got := append([]interface{}{}, tt.data...)
+				i := iterator.New(got)
+				begin, end := i.Begin(), i.End()
+				s.fn(begin, end)
+				if !reflect.DeepEqual(got, want) {
+					t.Fatalf("result is not sorted")
+				}
+				if begin.Index() != 0 {
+					t.Fatalf("begin moved to %d", begin.Index())
+				}
+			})
+		}
+	}
+}
+
+func TestRadixSortCmp(t *testing.T) {
+	type pair struct{ key, seq int }
+	data := make([]interface{}, 0, 0)
+	for i := 0; i < 300; i++ {
+		data = append(data, pair{i % 7, i})
+	}
+	cmp := func(a, b interface{}) int { return a.(pair).key - b.(pair).key }
+	i := iterator.New(data)
+	RadixSort(i.Begin(), i.End(), cmp)
+	for j := 1; j < len(data); j++ {
+		if cmp(data[j-1], data[j]) > 0 {
+			t.Fatalf("not sorted at %d", j)
+		}
+	}
+}
+
+func TestRadixSortSubRange(t *testing.T) {
+	data := []interface{}{9, 8, 7, 3, 1, 2, 0, -1}
+	i := iterator.New(data)
+	CountingSort(i.Get(2), iterator.New(data).Get(5))
+	want := []interface{}{9, 8, 1, 2, 3, 7, 0, -1}
+	if !reflect.DeepEqual(data, want) {
+		t.Fatalf("got %v, want %v", data, want)
+	}
+}
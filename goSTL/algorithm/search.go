@@ -45,6 +45,8 @@ func Search(begin, end *iterator.Iterator, e interface{}, Cmp ...comparator.Comp
 //		通过比较器对传入两个迭代器中的元素集合进行二分查找
 //		找到后返回该元素的下标
 //		若该元素不在该部分内存在,则返回-1
+//		元素是否相等完全由比较器决定,比较结果为0即视为找到
+//		以便按关键字查找自定义结构体
 //@author     	hlccd		2021-07-2
 //@receiver		nil
 //@param    	begin		*iterator.Iterator			待排序的起始迭代器
@@ -55,6 +57,10 @@ func Search(begin, end *iterator.Iterator, e interface{}, Cmp ...comparator.Comp
 func search(begin, end *iterator.Iterator, e interface{}, cmp comparator.Comparator) (idx int) {
 	//通过二分查找的方式寻找该元素
 	m, l, r := 0, begin.Index(), end.Index()
+	if l < 0 || l > r {
+		//区间为空,该元素必然不存在
+		return -1
+	}
 	for l < r {
 		m = (l + r) / 2
 		if cmp(begin.Get(m).Value(), e) < 0 {
@@ -64,10 +70,26 @@ func search(begin, end *iterator.Iterator, e interface{}, cmp comparator.Compara
 		}
 	}
 	//查找结束
-	if begin.Get(l).Value() == e {
+	if cmp(begin.Get(l).Value(), e) == 0 {
 		//该元素存在,返回下标
 		return l
 	}
 	//该元素不存在,返回-1
 	return -1
 }
+
+//@title    BinarySearch
+//@description
+//		通过比较器对传入两个迭代器中的元素集合进行二分查找
+//		仅判断该元素是否存在,存在返回true,否则返回false
+//		元素是否相等完全由比较器决定
+//		若未传入比较器且并非默认类型则返回false
+//@receiver		nil
+//@param    	begin		*iterator.Iterator			待查找的起始迭代器
+//@param    	end			*iterator.Iterator			待查找的末尾迭代器
+//@param    	e			interface{}					待查找元素
+//@param    	Cmp			...comparator.Comparator	比较器
+//@return    	b			bool						该元素存在?
+func BinarySearch(begin, end *iterator.Iterator, e interface{}, Cmp ...comparator.Comparator) (b bool) {
+	return Search(begin, end, e, Cmp...) != -1
+}
@@ -0,0 +1,44 @@
+package algorithm
+
+import (
+	"testing"
+
+	"github.com/hlccd/goSTL/utils/iterator"
+)
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		name  string
+		key   int
+		idx   int
+		found bool
+	}{
+		{"absent before first", 0, -1, false},
+		{"first", 1, 0, true},
+		{"duplicate run", 3, 1, true},
+		{"absent inside", 4, -1, false},
+		{"single in middle", 5, 4, true},
+		{"duplicate run before last", 7, 5, true},
+		{"last", 9, 7, true},
+		{"absent after last", 10, -1, false},
+	}
+	i := iterator.New(records())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := record{key: tt.key}
+			if got := Search(i.Begin(), i.End(), e, recordCmp); got != tt.idx {
+				t.Errorf("Search = %d, want %d", got, tt.idx)
+			}
+			if got := BinarySearch(i.Begin(), i.End(), e, recordCmp); got != tt.found {
+				t.Errorf("BinarySearch = %v, want %v", got, tt.found)
+			}
+		})
+	}
+}
+
+func TestSearchEmpty(t *testing.T) {
+	i := iterator.New(make([]interface{}, 0, 0))
+	if BinarySearch(i.Begin(), i.End(), record{key: 1}, recordCmp) {
+		t.Error("BinarySearch found an element in an empty range")
+	}
+}
@@ -0,0 +1,188 @@
+package stringSearch
+
+//@Title		stringSearch
+//@Description
+//		字符串匹配算法包
+//		该包内算法可在文本中查找模式串的全部出现位置
+//		包括KMP、Z函数、Boyer-Moore-Horspool和Rabin-Karp四种算法
+//		匹配以字节为单位进行,返回的位置为字节下标,出现位置允许相互重叠
+//		模式串为空时不进行匹配,返回空切片
+//		对于任意类型元素的匹配,可使用algorithm包中的FindSubsequence
+
+//@title    PrefixFunction
+//@description
+//		求字符串s的前缀函数
+//		pi[i]为s[0:i+1]中相等的真前缀与真后缀的最大长度
+//@receiver		nil
+//@param    	s			string						待求字符串
+//@return    	pi			[]int						前缀函数
+func PrefixFunction(s string) (pi []int) {
+	pi = make([]int, len(s))
+	for i, k := 1, 0; i < len(s); i++ {
+		for k > 0 && s[i] != s[k] {
+			k = pi[k-1]
+		}
+		if s[i] == s[k] {
+			k++
+		}
+		pi[i] = k
+	}
+	return pi
+}
+
+//@title    KMP
+//@description
+//		使用KMP算法在text中查找pattern的全部出现位置
+//		匹配失败时根据模式串的前缀函数回退,文本指针不回退
+//		时间复杂度为O(n+m)
+//@receiver		nil
+//@param    	text		string						文本
+//@param    	pattern		string						模式串
+//@return    	ps			[]int						全部出现位置的下标
+func KMP(text, pattern string) (ps []int) {
+	ps = make([]int, 0, 0)
+	if len(pattern) == 0 {
+		return ps
+	}
+	pi := PrefixFunction(pattern)
+	for i, k := 0, 0; i < len(text); i++ {
+		for k > 0 && text[i] != pattern[k] {
+			k = pi[k-1]
+		}
+		if text[i] == pattern[k] {
+			k++
+		}
+		if k == len(pattern) {
+			ps = append(ps, i-k+1)
+			k = pi[k-1]
+		}
+	}
+	return ps
+}
+
+//@title    ZFunction
+//@description
+//		求字符串s的Z函数
+//		z[i]为s与s[i:]的最长公共前缀长度,约定z[0]为len(s)
+//		利用已求得的最右匹配区间[l,r)加速计算,时间复杂度为O(n)
+//@receiver		nil
+//@param    	s			string						待求字符串
+//@return    	z			[]int						Z函数
+func ZFunction(s string) (z []int) {
+	z = make([]int, len(s))
+	if len(s) == 0 {
+		return z
+	}
+	z[0] = len(s)
+	for i, l, r := 1, 0, 0; i < len(s); i++ {
+		if i < r {
+			z[i] = r - i
+			if z[i-l] < z[i] {
+				z[i] = z[i-l]
+			}
+		}
+		for i+z[i] < len(s) && s[z[i]] == s[i+z[i]] {
+			z[i]++
+		}
+		if i+z[i] > r {
+			l, r = i, i+z[i]
+		}
+	}
+	return z
+}
+
+//@title    ZSearch
+//@description
+//		使用Z函数在text中查找pattern的全部出现位置
+//		对pattern与text的拼接求Z函数,Z值不小于模式串长度的位置即为出现位置
+//		拼接时不使用分隔符,而是将Z值截断到模式串长度以避免越界匹配
+//		时间复杂度为O(n+m)
+//@receiver		nil
+//@param    	text		string						文本
+//@param    	pattern		string						模式串
+//@return    	ps			[]int						全部出现位置的下标
+func ZSearch(text, pattern string) (ps []int) {
+	ps = make([]int, 0, 0)
+	m := len(pattern)
+	if m == 0 || m > len(text) {
+		return ps
+	}
+	z := ZFunction(pattern + text)
+	for i := m; i+m <= len(z); i++ {
+		if z[i] >= m {
+			ps = append(ps, i-m)
+		}
+	}
+	return ps
+}
+
+//@title    BoyerMooreHorspool
+//@description
+//		使用Boyer-Moore-Horspool算法在text中查找pattern的全部出现位置
+//		从模式串末尾向前比较,失配时根据文本窗口最后一个字节在模式串中的位置确定跳跃距离
+//		平均情况下为亚线性,最坏情况下为O(nm)
+//@receiver		nil
+//@param    	text		string						文本
+//@param    	pattern		string						模式串
+//@return    	ps			[]int						全部出现位置的下标
+func BoyerMooreHorspool(text, pattern string) (ps []int) {
+	ps = make([]int, 0, 0)
+	m := len(pattern)
+	if m == 0 || m > len(text) {
+		return ps
+	}
+	//求坏字符跳跃表,未在模式串中出现的字节可直接跳过整个模式串
+	var shift [256]int
+	for i := range shift {
+		shift[i] = m
+	}
+	for i := 0; i < m-1; i++ {
+		shift[pattern[i]] = m - 1 - i
+	}
+	for i := 0; i+m <= len(text); i += shift[text[i+m-1]] {
+		j := m - 1
+		for j >= 0 && text[i+j] == pattern[j] {
+			j--
+		}
+		if j < 0 {
+			ps = append(ps, i)
+		}
+	}
+	return ps
+}
+
+//@title    RabinKarp
+//@description
+//		使用Rabin-Karp算法在text中查找pattern的全部出现位置
+//		以滚动哈希计算文本中每个长度为m的窗口的哈希值,哈希值相同时再逐字节确认
+//		哈希以2^64为模,利用无符号整数的自然溢出计算
+//		平均时间复杂度为O(n+m)
+//@receiver		nil
+//@param    	text		string						文本
+//@param    	pattern		string						模式串
+//@return    	ps			[]int						全部出现位置的下标
+func RabinKarp(text, pattern string) (ps []int) {
+	ps = make([]int, 0, 0)
+	m := len(pattern)
+	if m == 0 || m > len(text) {
+		return ps
+	}
+	const base = uint64(1000000007)
+	//pow为base的m次方,用于移出窗口首字节
+	var hp, ht, pow uint64 = 0, 0, 1
+	for i := 0; i < m; i++ {
+		hp = hp*base + uint64(pattern[i])
+		ht = ht*base + uint64(text[i])
+		pow *= base
+	}
+	for i := 0; ; i++ {
+		if ht == hp && text[i:i+m] == pattern {
+			ps = append(ps, i)
+		}
+		if i+m >= len(text) {
+			break
+		}
+		ht = ht*base + uint64(text[i+m]) - pow*uint64(text[i])
+	}
+	return ps
+}
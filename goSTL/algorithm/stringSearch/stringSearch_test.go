@@ -0,0 +1,129 @@
+package stringSearch
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+//naive逐位置比较,作为参照
+func naive(text, pattern string) []int {
+	ps := make([]int, 0, 0)
+	if len(pattern) == 0 {
+		return ps
+	}
+	for i := 0; i+len(pattern) <= len(text); i++ {
+		if text[i:i+len(pattern)] == pattern {
+			ps = append(ps, i)
+		}
+	}
+	return ps
+}
+
+var searchers = []struct {
+	name string
+	fn   func(text, pattern string) []int
+}{
+	{"KMP", KMP},
+	{"ZSearch", ZSearch},
+	{"BoyerMooreHorspool", BoyerMooreHorspool},
+	{"RabinKarp", RabinKarp},
+}
+
+func TestSearchCases(t *testing.T) {
+	tests := []struct {
+		name, text, pattern string
+	}{
+		{"empty pattern", "abc", ""},
+		{"empty text", "", "a"},
+		{"pattern longer than text", "ab", "abc"},
+		{"whole text", "abc", "abc"},
+		{"overlapping", "aaaaa", "aa"},
+		{"self overlap", "abababab", "abab"},
+		{"at both ends", "xyzabcxyz", "xyz"},
+		{"absent", "abcdefg", "gf"},
+		{"bad char shift", "abcabdabcabd", "abd"},
+		{"high bytes", "\xff\x00\xff\x00\xff", "\xff\x00\xff"},
+	}
+	for _, tt := range tests {
+		want := naive(tt.text, tt.pattern)
+		for _, s := range searchers {
+			t.Run(tt.name+"/"+s.name, func(t *testing.T) {
+				if got := s.fn(tt.text, tt.pattern); !reflect.DeepEqual(got, want) {
+					t.Errorf("%s(%q, %q) = %v, want %v", s.name, tt.text, tt.pattern, got, want)
+				}
+			})
+		}
+	}
+}
+
+func TestSearchRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	gen := func(n int, alpha string) string {
+		var b strings.Builder
+		for i := 0; i < n; i++ {
+			b.WriteByte(alpha[r.Intn(len(alpha))])
+		}
+		return b.String()
+	}
+	for it := 0; it < 2000; it++ {
+		//小字母表使匹配和部分匹配足够频繁
+		alpha := "ab"
+		if it%2 == 1 {
+			alpha = "abcd"
+		}
+		text := gen(r.Intn(60), alpha)
+		pattern := gen(1+r.Intn(6), alpha)
+		if r.Intn(4) == 0 && len(text) > 0 {
+			//直接取文本的子串,保证至少匹配一次
+			i := r.Intn(len(text))
+			pattern = text[i : i+1+r.Intn(len(text)-i)]
+		}
+		want := naive(text, pattern)
+		for _, s := range searchers {
+			if got := s.fn(text, pattern); !reflect.DeepEqual(got, want) {
+				t.Fatalf("%s(%q, %q) = %v, want %v", s.name, text, pattern, got, want)
+			}
+		}
+	}
+}
+
+func TestPrefixAndZFunction(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for it := 0; it < 500; it++ {
+		b := make([]byte, r.Intn(30))
+		for i := range b {
+			b[i] = "ab"[r.Intn(2)]
+		}
+		s := string(b)
+		pi, z := PrefixFunction(s), ZFunction(s)
+		if len(pi) != len(s) || len(z) != len(s) {
+			t.Fatalf("%q: len(pi)=%d len(z)=%d", s, len(pi), len(z))
+		}
+		for i := range s {
+			//最长的相等真前缀与真后缀
+			want := 0
+			for k := i; k > 0; k-- {
+				if s[:k] == s[i+1-k:i+1] {
+					want = k
+					break
+				}
+			}
+			if pi[i] != want {
+				t.Fatalf("%q: pi[%d] = %d, want %d", s, i, pi[i], want)
+			}
+			if i == 0 {
+				continue
+			}
+			//从i开始与整个串的最长公共前缀
+			want = 0
+			for i+want < len(s) && s[want] == s[i+want] {
+				want++
+			}
+			if z[i] != want {
+				t.Fatalf("%q: z[%d] = %d, want %d", s, i, z[i], want)
+			}
+		}
+	}
+}
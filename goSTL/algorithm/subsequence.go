@@ -0,0 +1,130 @@
+package algorithm
+
+//@Title		algorithm
+//@Description
+//		算法包
+//		该部分通过传入两组迭代器在前一组元素中查找后一组元素作为连续子序列出现的位置
+//		元素是否相等完全由比较器决定
+//		使用KMP算法进行匹配,时间复杂度为O(n+m)
+//		若仅需对字符串进行匹配,可使用stringSearch包
+import (
+	"github.com/hlccd/goSTL/utils/comparator"
+	"github.com/hlccd/goSTL/utils/iterator"
+)
+
+//@title    FindSubsequence
+//@description
+//		在待查找的元素集合中查找模式元素集合首次作为连续子序列出现的位置
+//		找到后返回该位置首元素在待查找元素集合中的下标,不存在则返回-1
+//		若模式元素集合为空,则返回待查找元素集合的起始下标
+//		若未传入比较器且并非默认类型则返回-1
+//@receiver		nil
+//@param    	haystackBegin	*iterator.Iterator			待查找的起始迭代器
+//@param    	haystackEnd		*iterator.Iterator			待查找的末尾迭代器
+//@param    	needleBegin		*iterator.Iterator			模式的起始迭代器
+//@param    	needleEnd		*iterator.Iterator			模式的末尾迭代器
+//@param    	Cmp				...comparator.Comparator	比较器
+//@return    	idx				int							首次出现的下标
+func FindSubsequence(haystackBegin, haystackEnd, needleBegin, needleEnd *iterator.Iterator, Cmp ...comparator.Comparator) (idx int) {
+	l := haystackBegin.Index()
+	hs, ns := collect(haystackBegin, haystackEnd), collect(needleBegin, needleEnd)
+	if len(ns) == 0 {
+		return l
+	}
+	//判断比较器是否有效
+	var cmp comparator.Comparator
+	cmp = nil
+	if len(Cmp) > 0 {
+		cmp = Cmp[0]
+	} else {
+		cmp = comparator.GetCmp(ns[0])
+	}
+	if cmp == nil {
+		return -1
+	}
+	p := kmp(hs, ns, cmp, true)
+	if len(p) == 0 {
+		return -1
+	}
+	return l + p[0]
+}
+
+//@title    FindEnd
+//@description
+//		在待查找的元素集合中查找模式元素集合最后一次作为连续子序列出现的位置
+//		找到后返回该位置首元素在待查找元素集合中的下标,不存在则返回-1
+//		若模式元素集合为空,则返回待查找元素集合末尾下标的后一位
+//		若未传入比较器且并非默认类型则返回-1
+//@receiver		nil
+//@param    	haystackBegin	*iterator.Iterator			待查找的起始迭代器
+//@param    	haystackEnd		*iterator.Iterator			待查找的末尾迭代器
+//@param    	needleBegin		*iterator.Iterator			模式的起始迭代器
+//@param    	needleEnd		*iterator.Iterator			模式的末尾迭代器
+//@param    	Cmp				...comparator.Comparator	比较器
+//@return    	idx				int							最后一次出现的下标
+func FindEnd(haystackBegin, haystackEnd, needleBegin, needleEnd *iterator.Iterator, Cmp ...comparator.Comparator) (idx int) {
+	l := haystackBegin.Index()
+	hs, ns := collect(haystackBegin, haystackEnd), collect(needleBegin, needleEnd)
+	if len(ns) == 0 {
+		return l + len(hs)
+	}
+	//判断比较器是否有效
+	var cmp comparator.Comparator
+	cmp = nil
+	if len(Cmp) > 0 {
+		cmp = Cmp[0]
+	} else {
+		cmp = comparator.GetCmp(ns[0])
+	}
+	if cmp == nil {
+		return -1
+	}
+	p := kmp(hs, ns, cmp, false)
+	if len(p) == 0 {
+		return -1
+	}
+	return l + p[len(p)-1]
+}
+
+//@title    kmp
+//@description
+//		使用KMP算法在hs中查找ns出现的全部位置
+//		先求出ns的前缀函数,匹配失败时根据前缀函数回退,避免重复比较
+//		若onlyFirst为true则找到首个位置后立即返回
+//@receiver		nil
+//@param    	hs			[]interface{}				待查找的元素集合
+//@param    	ns			[]interface{}				模式元素集合,非空
+//@param    	cmp			comparator.Comparator		比较器
+//@param    	onlyFirst	bool						是否只查找首个位置
+//@return    	ps			[]int						全部出现位置的下标
+func kmp(hs, ns []interface{}, cmp comparator.Comparator, onlyFirst bool) (ps []int) {
+	//求前缀函数,pi[i]为ns[0:i+1]的最长相等真前后缀长度
+	pi := make([]int, len(ns))
+	for i, k := 1, 0; i < len(ns); i++ {
+		for k > 0 && cmp(ns[i], ns[k]) != 0 {
+			k = pi[k-1]
+		}
+		if cmp(ns[i], ns[k]) == 0 {
+			k++
+		}
+		pi[i] = k
+	}
+	//进行匹配
+	ps = make([]int, 0, 0)
+	for i, k := 0, 0; i < len(hs); i++ {
+		for k > 0 && cmp(hs[i], ns[k]) != 0 {
+			k = pi[k-1]
+		}
+		if cmp(hs[i], ns[k]) == 0 {
+			k++
+		}
+		if k == len(ns) {
+			ps = append(ps, i-k+1)
+			if onlyFirst {
+				return ps
+			}
+			k = pi[k-1]
+		}
+	}
+	return ps
+}
@@ -37,6 +37,10 @@ type cbTreer interface {
 	Push(e interface{})               //向二叉树中插入元素e
 	Pop()                             //从二叉树中弹出顶部元素
 	Top() (e interface{})             //返回该二叉树的顶部元素
+	PushAll(es ...interface{})        //向二叉树中插入全部元素
+	Merge(other *cbTree)              //将另一个二叉树的全部元素移入该二叉树
+	PopN(k int) (es []interface{})    //按序弹出顶部的k个元素
+	Drain() (es []interface{})        //按序弹出全部元素
 }
 
 //@title    New
@@ -62,6 +66,73 @@ func New(Cmp ...comparator.Comparator) (cb *cbTree) {
 	}
 }
 
+//@title    FromSlice
+//@description
+//		以传入的切片新建一个cbTree完全二叉树容器并返回
+//		先在切片上以Floyd建堆法建堆,再按层序依次生成节点,时间复杂度为O(n)
+//		若有传入的比较器,则将传入的第一个比较器设为该二叉树的比较器,否则以首个元素寻找默认比较器
+//		若无法确定比较器则返回空树
+//@receiver		nil
+//@param    	data		[]interface{}				初始元素
+//@param    	Cmp			 ...comparator.Comparator	cbTree比较器集
+//@return    	cb        	*cbTree						新建的cbTree指针
+func FromSlice(data []interface{}, Cmp ...comparator.Comparator) (cb *cbTree) {
+	cb = New(Cmp...)
+	if len(data) == 0 {
+		return cb
+	}
+	if cb.cmp == nil {
+		cb.cmp = comparator.GetCmp(data[0])
+	}
+	if cb.cmp == nil {
+		return cb
+	}
+	cb.rebuild(append(make([]interface{}, 0, len(data)), data...))
+	return cb
+}
+
+//@title    rebuild
+//@description
+//		以cbTree完全二叉树做接收者
+//		以传入的全部元素重建该二叉树
+//		先对切片自最后一个非叶子节点起依次向前进行下沉使其成为堆
+//		随后按层序生成节点,下标为i的节点的父节点下标为(i-1)/2
+//@receiver		cb			*cbTree					接受者cbTree的指针
+//@param    	es			[]interface{}			全部元素,将被就地调整
+//@return    	nil
+func (cb *cbTree) rebuild(es []interface{}) {
+	for p := len(es)/2 - 1; p >= 0; p-- {
+		for i := p; 2*i+1 < len(es); {
+			q := 2*i + 1
+			if q+1 < len(es) && cb.cmp(es[q+1], es[q]) < 0 {
+				q++
+			}
+			if cb.cmp(es[i], es[q]) <= 0 {
+				break
+			}
+			es[i], es[q] = es[q], es[i]
+			i = q
+		}
+	}
+	ns := make([]*node, len(es), len(es))
+	for i := 0; i < len(es); i++ {
+		if i == 0 {
+			ns[i] = newNode(nil, es[i])
+			continue
+		}
+		ns[i] = newNode(ns[(i-1)/2], es[i])
+		if i%2 == 1 {
+			ns[(i-1)/2].left = ns[i]
+		} else {
+			ns[(i-1)/2].right = ns[i]
+		}
+	}
+	cb.root, cb.size = nil, len(es)
+	if len(ns) > 0 {
+		cb.root = ns[0]
+	}
+}
+
 //@title    Iterator
 //@description
 //		以cbTree完全二叉树做接收者
@@ -186,6 +257,19 @@ func (cb *cbTree) Pop() {
 		return
 	}
 	cb.mutex.Lock()
+	cb.pop()
+	cb.mutex.Unlock()
+}
+
+//@title    pop
+//@description
+//		以cbTree完全二叉树做接收者
+//		删除顶部元素并返回,调用时需持有锁且该二叉树不为空
+//@receiver		cb			*cbTree					接受者cbTree的指针
+//@param    	nil
+//@return    	e 			interface{}				被删除的顶部元素
+func (cb *cbTree) pop() (e interface{}) {
+	e = cb.root.value
 	if cb.size == 1 {
 		//该二叉树仅剩根节点,直接删除即可
 		cb.root = nil
@@ -194,7 +278,7 @@ func (cb *cbTree) Pop() {
 		cb.root.delete(cb.size, cb.cmp)
 	}
 	cb.size--
-	cb.mutex.Unlock()
+	return e
 }
 
 //@title    Top
@@ -215,3 +299,99 @@ func (cb *cbTree) Top() (e interface{}) {
 	cb.mutex.Unlock()
 	return e
 }
+
+//@title    PushAll
+//@description
+//		以cbTree完全二叉树做接收者
+//		向二叉树插入全部元素,只需加锁一次
+//		若插入的元素数量不少于已有元素数量,则与已有元素一同重建,否则逐个插入
+//		若无法确定比较器则不插入
+//@receiver		cb			*cbTree					接受者cbTree的指针
+//@param    	es			...interface{}			待插入元素
+//@return    	nil
+func (cb *cbTree) PushAll(es ...interface{}) {
+	if cb == nil || len(es) == 0 {
+		return
+	}
+	cb.mutex.Lock()
+	if cb.cmp == nil {
+		cb.cmp = comparator.GetCmp(es[0])
+	}
+	if cb.cmp == nil {
+		cb.mutex.Unlock()
+		return
+	}
+	if len(es) >= cb.size {
+		cb.rebuild(append(cb.root.frontOrder(), es...))
+	} else {
+		for i := 0; i < len(es); i++ {
+			cb.size++
+			cb.root.insert(cb.size, es[i], cb.cmp)
+		}
+	}
+	cb.mutex.Unlock()
+}
+
+//@title    Merge
+//@description
+//		以cbTree完全二叉树做接收者
+//		将另一个二叉树的全部元素移入该二叉树,移入后另一个二叉树为空
+//		合并后以全部元素重建,时间复杂度为O(n+m)
+//		若两者为同一个二叉树则不做合并
+//@receiver		cb			*cbTree					接受者cbTree的指针
+//@param    	other		*cbTree					待合并的二叉树
+//@return    	nil
+func (cb *cbTree) Merge(other *cbTree) {
+	if cb == nil || other == nil || cb == other {
+		return
+	}
+	other.mutex.Lock()
+	es, cmp := other.root.frontOrder(), other.cmp
+	other.root, other.size = nil, 0
+	other.mutex.Unlock()
+	if len(es) == 0 {
+		return
+	}
+	cb.mutex.Lock()
+	if cb.cmp == nil {
+		cb.cmp = cmp
+	}
+	cb.rebuild(append(cb.root.frontOrder(), es...))
+	cb.mutex.Unlock()
+}
+
+//@title    PopN
+//@description
+//		以cbTree完全二叉树做接收者
+//		依次弹出顶部的k个元素并按弹出顺序返回
+//		若元素不足k个则弹出全部元素
+//@receiver		cb			*cbTree					接受者cbTree的指针
+//@param    	k			int						弹出的数量
+//@return    	es			[]interface{}			弹出的元素
+func (cb *cbTree) PopN(k int) (es []interface{}) {
+	es = make([]interface{}, 0, 0)
+	if cb == nil || k <= 0 {
+		return es
+	}
+	cb.mutex.Lock()
+	for len(es) < k && cb.size > 0 {
+		es = append(es, cb.pop())
+	}
+	cb.mutex.Unlock()
+	return es
+}
+
+//@title    Drain
+//@description
+//		以cbTree完全二叉树做接收者
+//		依次弹出全部元素并按弹出顺序返回,即堆排序的结果
+//		弹出后该二叉树为空
+//@receiver		cb			*cbTree					接受者cbTree的指针
+//@param    	nil
+//@return    	es			[]interface{}			弹出的元素
+func (cb *cbTree) Drain() (es []interface{}) {
+	if cb == nil {
+		return make([]interface{}, 0, 0)
+	}
+	return cb.PopN(cb.Size())
+}
@@ -176,25 +176,22 @@ func (n *node) delete(num int, cmp comparator.Comparator) {
 //@description
 //		以node节点做接收者
 //		对该节点进行下沉
-//		当该存在右节点且小于自身元素时,与右节点进行交换并继续下沉
-//		否则当该存在左节点且小于自身元素时,与左节点进行交换并继续下沉
-//		当左右节点都不存在或都大于自身时下沉停止
+//		在左右子节点中选出较小的一个,若其小于自身元素则进行交换并继续下沉
+//		当左右节点都不存在或都不小于自身时下沉停止
 //@auth      	hlccd		2021-07-14
 //@receiver		n			*node					接受者node的指针
 //@param    	cmp			comparator.Comparator	比较器,在节点下沉时使用
 //@return    	nil
 func (n *node) down(cmp comparator.Comparator) {
-	if n == nil {
+	if n == nil || n.left == nil {
 		return
 	}
-	if n.right != nil && cmp(n.left.value, n.right.value) >= 0 {
-		n.right.value, n.value = n.value, n.right.value
-		n.right.down(cmp)
-		return
+	m := n.left
+	if n.right != nil && cmp(n.right.value, n.left.value) < 0 {
+		m = n.right
 	}
-	if n.left != nil && cmp(n.value, n.left.value) >= 0 {
-		n.left.value, n.value = n.value, n.left.value
-		n.left.down(cmp)
-		return
+	if cmp(n.value, m.value) > 0 {
+		m.value, n.value = n.value, m.value
+		m.down(cmp)
 	}
 }
@@ -0,0 +1,331 @@
+package cidrTrie
+
+//@Title		cidrTrie
+//@Description
+//		CIDR前缀树-CIDR Trie
+//		以二进制位为单位的路径压缩前缀树,用于存储IPv4和IPv6网络前缀并进行最长前缀匹配
+//		IPv4和IPv6前缀分别存放于两棵树中,IPv4映射的IPv6地址(::ffff:a.b.c.d)视为IPv6地址
+//		插入的前缀会先将主机位清零,即10.1.2.3/8与10.0.0.0/8视为同一前缀
+//		由于路径经过压缩,节点数不超过前缀数量的两倍,查找时间与前缀长度成正比
+//		插入时若不传入元素,则以前缀本身作为存储的元素
+import (
+	"github.com/hlccd/goSTL/utils/iterator"
+	"net/netip"
+	"sync"
+)
+
+//cidrTrie前缀树结构体
+//该实例存储IPv4和IPv6两棵前缀树的根节点以及前缀的数量
+type cidrTrie struct {
+	v4    *node      //IPv4前缀树的根节点指针
+	v6    *node      //IPv6前缀树的根节点指针
+	num   int        //前缀的数量
+	mutex sync.Mutex //并发控制锁
+}
+
+//Pair前缀对结构体
+//用于同时返回前缀树中的前缀及其对应的元素
+type Pair struct {
+	Prefix netip.Prefix //前缀
+	Value  interface{}  //前缀对应的元素
+}
+
+//cidrTrie前缀树容器接口
+//存放了cidrTrie前缀树可使用的函数
+//对应函数介绍见下方
+type cidrTrieer interface {
+	Iterator() (i *iterator.Iterator)                             //返回包含该前缀树的所有前缀
+	Size() (num int)                                              //返回该前缀树中保存的前缀的个数
+	Clear()                                                       //清空该前缀树
+	Empty() (b bool)                                              //判断该前缀树是否为空
+	Insert(p netip.Prefix, e interface{})                         //向前缀树中插入前缀p及其元素e
+	Delete(p netip.Prefix)                                        //从前缀树中删除前缀p
+	Find(p netip.Prefix) (e interface{})                          //返回前缀p的元素
+	Lookup(a netip.Addr) (p netip.Prefix, e interface{}, ok bool) //返回包含地址a的最长前缀及其元素
+	Covering(p netip.Prefix) (ps []Pair)                          //返回包含前缀p的全部前缀及其元素
+	Covered(p netip.Prefix) (ps []Pair)                           //返回被前缀p包含的全部前缀及其元素
+	Walk(fn func(p netip.Prefix, e interface{}) bool)             //按序遍历全部前缀及其元素
+}
+
+//@title    New
+//@description
+//		新建一个cidrTrie前缀树容器并返回
+//		初始时两棵树均为空
+//@receiver		nil
+//@param    	nil
+//@return    	t        	*cidrTrie					新建的cidrTrie指针
+func New() (t *cidrTrie) {
+	return &cidrTrie{
+		v4:    nil,
+		v6:    nil,
+		num:   0,
+		mutex: sync.Mutex{},
+	}
+}
+
+//@title    rootOf
+//@description
+//		以cidrTrie前缀树做接收者
+//		返回地址a所属的树的根节点所在位置
+//@receiver		t			*cidrTrie				接受者cidrTrie的指针
+//@param    	a			netip.Addr				地址
+//@return    	n        	**node					根节点所在位置
+func (t *cidrTrie) rootOf(a netip.Addr) (n **node) {
+	if a.Is4() {
+		return &t.v4
+	}
+	return &t.v6
+}
+
+//@title    Iterator
+//@description
+//		以cidrTrie前缀树做接收者
+//		将该前缀树中所有保存的前缀按序放入迭代器中
+//		IPv4前缀在前,同族前缀按地址升序,地址相同时较短的前缀在前
+//@receiver		t			*cidrTrie				接受者cidrTrie的指针
+//@param    	nil
+//@return    	i        	*iterator.Iterator		新建的Iterator迭代器指针
+func (t *cidrTrie) Iterator() (i *iterator.Iterator) {
+	if t == nil {
+		return iterator.New(make([]interface{}, 0, 0))
+	}
+	t.mutex.Lock()
+	es := make([]interface{}, 0, t.num)
+	fn := func(p netip.Prefix, e interface{}) bool {
+		es = append(es, p)
+		return true
+	}
+	t.v4.walk(fn)
+	t.v6.walk(fn)
+	i = iterator.New(es)
+	t.mutex.Unlock()
+	return i
+}
+
+//@title    Size
+//@description
+//		以cidrTrie前缀树做接收者
+//		返回该容器当前含有的前缀的数量
+//		如果容器为nil返回-1
+//@receiver		t			*cidrTrie				接受者cidrTrie的指针
+//@param    	nil
+//@return    	num        	int						容器中实际使用元素所占空间大小
+func (t *cidrTrie) Size() (num int) {
+	if t == nil {
+		return -1
+	}
+	return t.num
+}
+
+//@title    Clear
+//@description
+//		以cidrTrie前缀树做接收者
+//		将该容器中所承载的元素清空
+//@receiver		t			*cidrTrie				接受者cidrTrie的指针
+//@param    	nil
+//@return    	nil
+func (t *cidrTrie) Clear() {
+	if t == nil {
+		return
+	}
+	t.mutex.Lock()
+	t.v4, t.v6, t.num = nil, nil, 0
+	t.mutex.Unlock()
+}
+
+//@title    Empty
+//@description
+//		以cidrTrie前缀树做接收者
+//		判断该前缀树是否含有元素
+//		如果含有元素则不为空,返回false
+//		如果不含有元素则说明为空,返回true
+//		如果容器不存在,返回true
+//@receiver		t			*cidrTrie				接受者cidrTrie的指针
+//@param    	nil
+//@return    	b			bool					该容器是空的吗?
+func (t *cidrTrie) Empty() (b bool) {
+	if t.Size() > 0 {
+		return false
+	}
+	return true
+}
+
+//@title    Insert
+//@description
+//		以cidrTrie前缀树做接收者
+//		插入前缀p及其元素e,若e为nil则存放p本身
+//		p的主机位将被清零,若p已存在则覆盖其元素
+//		p无效时不做任何操作
+//@receiver		t			*cidrTrie				接受者cidrTrie的指针
+//@param    	p			netip.Prefix			待插入的前缀
+//@param    	e			interface{}				待插入元素
+//@return    	nil
+func (t *cidrTrie) Insert(p netip.Prefix, e interface{}) {
+	if t == nil || !p.IsValid() {
+		return
+	}
+	p = p.Masked()
+	if e == nil {
+		e = p
+	}
+	t.mutex.Lock()
+	if insert(t.rootOf(p.Addr()), p, e) {
+		t.num++
+	}
+	t.mutex.Unlock()
+}
+
+//@title    Delete
+//@description
+//		以cidrTrie前缀树做接收者
+//		删除前缀p,被p包含的其他前缀不受影响
+//@receiver		t			*cidrTrie				接受者cidrTrie的指针
+//@param    	p			netip.Prefix			待删除的前缀
+//@return    	nil
+func (t *cidrTrie) Delete(p netip.Prefix) {
+	if t == nil || !p.IsValid() {
+		return
+	}
+	p = p.Masked()
+	t.mutex.Lock()
+	if remove(t.rootOf(p.Addr()), p) {
+		t.num--
+	}
+	t.mutex.Unlock()
+}
+
+//@title    Find
+//@description
+//		以cidrTrie前缀树做接收者
+//		返回前缀p的元素
+//		若不存在该前缀则返回nil
+//@receiver		t			*cidrTrie				接受者cidrTrie的指针
+//@param    	p			netip.Prefix			待查找的前缀
+//@return    	e			interface{}				前缀p的元素
+func (t *cidrTrie) Find(p netip.Prefix) (e interface{}) {
+	if t == nil || !p.IsValid() {
+		return nil
+	}
+	p = p.Masked()
+	t.mutex.Lock()
+	for now := *t.rootOf(p.Addr()); now != nil && now.prefix.Bits() <= p.Bits() && now.prefix.Contains(p.Addr()); {
+		if now.prefix.Bits() == p.Bits() {
+			if now.set {
+				e = now.value
+			}
+			break
+		}
+		now = now.son[bitAt(p.Addr(), now.prefix.Bits())]
+	}
+	t.mutex.Unlock()
+	return e
+}
+
+//@title    Lookup
+//@description
+//		以cidrTrie前缀树做接收者
+//		返回包含地址a的最长前缀及其元素,即最长前缀匹配
+//		从根节点沿a的各位向下查找,记录途经的最后一个被插入过的前缀
+//		若不存在包含a的前缀则ok返回false
+//@receiver		t			*cidrTrie				接受者cidrTrie的指针
+//@param    	a			netip.Addr				待匹配的地址
+//@return    	p			netip.Prefix			最长的前缀
+//@return    	e			interface{}				该前缀对应的元素
+//@return    	ok			bool					存在这样的前缀?
+func (t *cidrTrie) Lookup(a netip.Addr) (p netip.Prefix, e interface{}, ok bool) {
+	if t == nil || !a.IsValid() {
+		return p, nil, false
+	}
+	a = a.WithZone("")
+	t.mutex.Lock()
+	for now := *t.rootOf(a); now != nil && now.prefix.Contains(a); {
+		if now.set {
+			p, e, ok = now.prefix, now.value, true
+		}
+		if now.prefix.Bits() == a.BitLen() {
+			break
+		}
+		now = now.son[bitAt(a, now.prefix.Bits())]
+	}
+	t.mutex.Unlock()
+	return p, e, ok
+}
+
+//@title    Covering
+//@description
+//		以cidrTrie前缀树做接收者
+//		返回包含前缀p的全部前缀及其元素,包括p本身
+//		结果按前缀长度升序排列
+//@receiver		t			*cidrTrie				接受者cidrTrie的指针
+//@param    	p			netip.Prefix			待查找的前缀
+//@return    	ps			[]Pair					包含p的全部前缀及其元素
+func (t *cidrTrie) Covering(p netip.Prefix) (ps []Pair) {
+	ps = make([]Pair, 0, 0)
+	if t == nil || !p.IsValid() {
+		return ps
+	}
+	p = p.Masked()
+	t.mutex.Lock()
+	for now := *t.rootOf(p.Addr()); now != nil && now.prefix.Bits() <= p.Bits() && now.prefix.Contains(p.Addr()); {
+		if now.set {
+			ps = append(ps, Pair{Prefix: now.prefix, Value: now.value})
+		}
+		if now.prefix.Bits() == p.Bits() {
+			break
+		}
+		now = now.son[bitAt(p.Addr(), now.prefix.Bits())]
+	}
+	t.mutex.Unlock()
+	return ps
+}
+
+//@title    Covered
+//@description
+//		以cidrTrie前缀树做接收者
+//		返回被前缀p包含的全部前缀及其元素,包括p本身
+//		先找到首个被p包含的节点,其子树中的前缀即为全部被p包含的前缀
+//		结果按地址升序排列,地址相同时较短的前缀在前
+//@receiver		t			*cidrTrie				接受者cidrTrie的指针
+//@param    	p			netip.Prefix			待查找的前缀
+//@return    	ps			[]Pair					被p包含的全部前缀及其元素
+func (t *cidrTrie) Covered(p netip.Prefix) (ps []Pair) {
+	ps = make([]Pair, 0, 0)
+	if t == nil || !p.IsValid() {
+		return ps
+	}
+	p = p.Masked()
+	t.mutex.Lock()
+	now := *t.rootOf(p.Addr())
+	for now != nil && now.prefix.Bits() < p.Bits() && now.prefix.Contains(p.Addr()) {
+		now = now.son[bitAt(p.Addr(), now.prefix.Bits())]
+	}
+	if now != nil && p.Contains(now.prefix.Addr()) && now.prefix.Bits() >= p.Bits() {
+		now.walk(func(q netip.Prefix, e interface{}) bool {
+			ps = append(ps, Pair{Prefix: q, Value: e})
+			return true
+		})
+	}
+	t.mutex.Unlock()
+	return ps
+}
+
+//@title    Walk
+//@description
+//		以cidrTrie前缀树做接收者
+//		按序遍历全部前缀及其元素,并依次调用fn
+//		IPv4前缀在前,同族前缀按地址升序,地址相同时较短的前缀在前
+//		当fn返回false时停止遍历
+//		遍历期间持有该前缀树的锁,故fn中不可调用该前缀树的函数
+//@receiver		t			*cidrTrie								接受者cidrTrie的指针
+//@param    	fn			func(p netip.Prefix, e interface{}) bool	遍历函数
+//@return    	nil
+func (t *cidrTrie) Walk(fn func(p netip.Prefix, e interface{}) bool) {
+	if t == nil {
+		return
+	}
+	t.mutex.Lock()
+	if t.v4.walk(fn) {
+		t.v6.walk(fn)
+	}
+	t.mutex.Unlock()
+}
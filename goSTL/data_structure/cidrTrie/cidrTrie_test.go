@@ -0,0 +1,166 @@
+package cidrTrie
+
+import (
+	"math/rand"
+	"net/netip"
+	"sort"
+	"testing"
+)
+
+//randPrefix在较小的地址空间中生成前缀,使前缀之间经常相互包含
+func randPrefix(r *rand.Rand) netip.Prefix {
+	if r.Intn(4) == 0 {
+		a := netip.AddrFrom16([16]byte{0x20, 0x01, 0x0d, 0xb8, byte(r.Intn(4)), byte(r.Intn(256))})
+		return netip.PrefixFrom(a, 24+r.Intn(25))
+	}
+	a := netip.AddrFrom4([4]byte{10, byte(r.Intn(4)), byte(r.Intn(256)), byte(r.Intn(256))})
+	//主机位不清零,插入时应自动清零
+	return netip.PrefixFrom(a, 8+r.Intn(25))
+}
+
+func randAddr(r *rand.Rand) netip.Addr {
+	p := randPrefix(r)
+	b := p.Addr().AsSlice()
+	for i := (p.Bits() + 7) / 8; i < len(b); i++ {
+		b[i] = byte(r.Intn(256))
+	}
+	a, _ := netip.AddrFromSlice(b)
+	return a
+}
+
+//sorted按族、地址、前缀长度排序,与Walk的顺序一致
+func sorted(model map[netip.Prefix]interface{}, keep func(q netip.Prefix) bool) []Pair {
+	ps := make([]Pair, 0, 0)
+	for q, v := range model {
+		if keep(q) {
+			ps = append(ps, Pair{Prefix: q, Value: v})
+		}
+	}
+	sort.Slice(ps, func(i, j int) bool {
+		if c := ps[i].Prefix.Addr().Compare(ps[j].Prefix.Addr()); c != 0 {
+			return c < 0
+		}
+		return ps[i].Prefix.Bits() < ps[j].Prefix.Bits()
+	})
+	return ps
+}
+
+func samePairs(a, b []Pair) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestCidrTrieModel(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	tr := New()
+	model := map[netip.Prefix]interface{}{}
+	for it := 0; it < 5000; it++ {
+		p := randPrefix(r)
+		switch r.Intn(4) {
+		case 0, 1:
+			var e interface{}
+			if r.Intn(2) == 0 {
+				e = it
+			}
+			tr.Insert(p, e)
+			if e == nil {
+				e = p.Masked()
+			}
+			model[p.Masked()] = e
+		case 2:
+			tr.Delete(p)
+			delete(model, p.Masked())
+		}
+		if tr.Size() != len(model) {
+			t.Fatalf("Size = %d, want %d", tr.Size(), len(model))
+		}
+		if got, want := tr.Find(p), model[p.Masked()]; got != want {
+			t.Fatalf("Find(%v) = %v, want %v", p, got, want)
+		}
+		//最长前缀匹配
+		a := randAddr(r)
+		var wp netip.Prefix
+		var we interface{}
+		wok := false
+		for q, v := range model {
+			if q.Contains(a) && (!wok || q.Bits() > wp.Bits()) {
+				wp, we, wok = q, v, true
+			}
+		}
+		if gp, ge, ok := tr.Lookup(a); ok != wok || gp != wp || ge != we {
+			t.Fatalf("Lookup(%v) = %v, %v, %v, want %v, %v, %v", a, gp, ge, ok, wp, we, wok)
+		}
+		q := randPrefix(r).Masked()
+		want := sorted(model, func(c netip.Prefix) bool {
+			return c.Bits() <= q.Bits() && c.Contains(q.Addr())
+		})
+		if got := tr.Covering(q); !samePairs(got, want) {
+			t.Fatalf("Covering(%v) = %v, want %v", q, got, want)
+		}
+		want = sorted(model, func(c netip.Prefix) bool {
+			return c.Bits() >= q.Bits() && q.Contains(c.Addr())
+		})
+		if got := tr.Covered(q); !samePairs(got, want) {
+			t.Fatalf("Covered(%v) = %v, want %v", q, got, want)
+		}
+	}
+	want := sorted(model, func(c netip.Prefix) bool { return true })
+	got := make([]Pair, 0, 0)
+	tr.Walk(func(p netip.Prefix, e interface{}) bool {
+		got = append(got, Pair{Prefix: p, Value: e})
+		return true
+	})
+	if !samePairs(got, want) {
+		t.Fatalf("Walk returned %d prefixes out of order, want %d", len(got), len(want))
+	}
+}
+
+func TestLookup(t *testing.T) {
+	tr := New()
+	for _, s := range []string{"0.0.0.0/0", "10.0.0.0/8", "10.1.0.0/16", "10.1.2.0/24", "10.1.2.3/32", "2001:db8::/32", "::ffff:10.0.0.0/104"} {
+		tr.Insert(netip.MustParsePrefix(s), s)
+	}
+	tests := []struct {
+		addr string
+		want string
+	}{
+		{"10.1.2.3", "10.1.2.3/32"},
+		{"10.1.2.4", "10.1.2.0/24"},
+		{"10.1.3.1", "10.1.0.0/16"},
+		{"10.2.0.1", "10.0.0.0/8"},
+		{"192.168.0.1", "0.0.0.0/0"},
+		{"2001:db8::1", "2001:db8::/32"},
+		//IPv4映射的IPv6地址视为IPv6地址
+		{"::ffff:10.1.2.3", "::ffff:10.0.0.0/104"},
+		{"2001:db9::1", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			_, e, ok := tr.Lookup(netip.MustParseAddr(tt.addr))
+			if tt.want == "" {
+				if ok {
+					t.Fatalf("Lookup = %v, want no match", e)
+				}
+				return
+			}
+			if !ok || e != tt.want {
+				t.Fatalf("Lookup = %v, %v, want %s", e, ok, tt.want)
+			}
+		})
+	}
+	//删除中间前缀不影响更长和更短的前缀
+	tr.Delete(netip.MustParsePrefix("10.1.0.0/16"))
+	if _, e, _ := tr.Lookup(netip.MustParseAddr("10.1.3.1")); e != "10.0.0.0/8" {
+		t.Fatalf("Lookup after Delete = %v, want 10.0.0.0/8", e)
+	}
+	if _, e, _ := tr.Lookup(netip.MustParseAddr("10.1.2.4")); e != "10.1.2.0/24" {
+		t.Fatalf("Lookup after Delete = %v, want 10.1.2.0/24", e)
+	}
+}
@@ -0,0 +1,177 @@
+package cidrTrie
+
+//@Title		cidrTrie
+//@Description
+//		CIDR前缀树的节点
+//		每个节点对应一个网络前缀,子节点的前缀均被父节点的前缀所包含
+//		son[0]和son[1]分别为在父节点前缀之后的下一位为0和为1的子节点
+//		路径经过压缩,除存有元素的节点外,每个节点均有两个子节点
+import (
+	"math/bits"
+	"net/netip"
+)
+
+//node树节点结构体
+//该节点是CIDR前缀树的树节点
+//prefix为该节点对应的网络前缀,其主机位均为0
+//set为true时说明该前缀被插入过,value为其对应的元素
+type node struct {
+	prefix netip.Prefix //该节点对应的网络前缀
+	set    bool         //该前缀是否被插入过
+	value  interface{}  //该节点中存储的元素
+	son    [2]*node     //下一位为0和为1的子节点
+}
+
+//@title    newNode
+//@description
+//		新建一个CIDR前缀树节点并返回
+//		将传入的前缀作为该节点对应的前缀,传入的元素e作为该节点的承载元素
+//@receiver		nil
+//@param    	p			netip.Prefix			该节点对应的前缀
+//@param    	set			bool					该前缀是否被插入过
+//@param    	e			interface{}				承载元素e
+//@return    	n        	*node					新建的CIDR前缀树节点的指针
+func newNode(p netip.Prefix, set bool, e interface{}) (n *node) {
+	return &node{
+		prefix: p,
+		set:    set,
+		value:  e,
+		son:    [2]*node{nil, nil},
+	}
+}
+
+//@title    bitAt
+//@description
+//		返回地址a的第i位,最高位为第0位
+//		IPv4地址按其自身的32位计算
+//@receiver		nil
+//@param    	a			netip.Addr				地址
+//@param    	i			int						位的下标
+//@return    	b        	int						该位的值
+func bitAt(a netip.Addr, i int) (b int) {
+	if a.Is4() {
+		i += 96
+	}
+	bs := a.As16()
+	return int(bs[i/8]>>(7-uint(i%8))) & 1
+}
+
+//@title    commonBits
+//@description
+//		返回前缀p和q的最长公共前缀的位数
+//		结果不超过两者中较短的前缀长度
+//@receiver		nil
+//@param    	p			netip.Prefix			前缀p
+//@param    	q			netip.Prefix			前缀q
+//@return    	l        	int						最长公共前缀的位数
+func commonBits(p, q netip.Prefix) (l int) {
+	max := p.Bits()
+	if q.Bits() < max {
+		max = q.Bits()
+	}
+	x, y := p.Addr().As16(), q.Addr().As16()
+	off := 0
+	if p.Addr().Is4() {
+		off = 12
+	}
+	for i := off; i < 16 && l < max; i++ {
+		if d := x[i] ^ y[i]; d != 0 {
+			l += bits.LeadingZeros8(d)
+			break
+		}
+		l += 8
+	}
+	if l > max {
+		l = max
+	}
+	return l
+}
+
+//@title    insert
+//@description
+//		将前缀p及其元素e插入以*n为根的子树中
+//		若当前节点的前缀包含p则向对应的子节点继续插入
+//		若p包含当前节点的前缀则以p新建节点并将当前节点作为其子节点
+//		否则以两者的最长公共前缀新建中间节点,并将两者作为其两个子节点
+//@receiver		nil
+//@param    	n			**node					子树根节点所在位置
+//@param    	p			netip.Prefix			待插入的前缀
+//@param    	e			interface{}				待插入元素
+//@return    	b        	bool					是否新增了前缀
+func insert(n **node, p netip.Prefix, e interface{}) (b bool) {
+	now := *n
+	if now == nil {
+		*n = newNode(p, true, e)
+		return true
+	}
+	l := commonBits(now.prefix, p)
+	switch {
+	case l == now.prefix.Bits() && l == p.Bits():
+		b = !now.set
+		now.set, now.value = true, e
+		return b
+	case l == now.prefix.Bits():
+		return insert(&now.son[bitAt(p.Addr(), l)], p, e)
+	case l == p.Bits():
+		m := newNode(p, true, e)
+		m.son[bitAt(now.prefix.Addr(), l)] = now
+		*n = m
+		return true
+	default:
+		mid := newNode(netip.PrefixFrom(p.Addr(), l).Masked(), false, nil)
+		mid.son[bitAt(now.prefix.Addr(), l)] = now
+		mid.son[bitAt(p.Addr(), l)] = newNode(p, true, e)
+		*n = mid
+		return true
+	}
+}
+
+//@title    remove
+//@description
+//		从以*n为根的子树中删除前缀p
+//		删除后不再被需要的节点将被移除,只有一个子节点且未被插入过的节点将被其子节点替代
+//@receiver		nil
+//@param    	n			**node					子树根节点所在位置
+//@param    	p			netip.Prefix			待删除的前缀
+//@return    	b        	bool					是否删除了前缀
+func remove(n **node, p netip.Prefix) (b bool) {
+	now := *n
+	if now == nil || now.prefix.Bits() > p.Bits() || !now.prefix.Contains(p.Addr()) {
+		return false
+	}
+	if now.prefix.Bits() == p.Bits() {
+		if !now.set {
+			return false
+		}
+		now.set, now.value = false, nil
+	} else if !remove(&now.son[bitAt(p.Addr(), now.prefix.Bits())], p) {
+		return false
+	}
+	if !now.set {
+		if now.son[0] == nil {
+			*n = now.son[1]
+		} else if now.son[1] == nil {
+			*n = now.son[0]
+		}
+	}
+	return true
+}
+
+//@title    walk
+//@description
+//		以node前缀树节点做接收者
+//		按地址升序遍历以该节点为起点的全部前缀及其元素,并依次调用fn
+//		地址相同时较短的前缀在前
+//		当fn返回false时停止遍历并返回false
+//@receiver		n			*node									接受者node的指针
+//@param    	fn			func(p netip.Prefix, e interface{}) bool	遍历函数
+//@return    	b        	bool									是否遍历完毕
+func (n *node) walk(fn func(p netip.Prefix, e interface{}) bool) (b bool) {
+	if n == nil {
+		return true
+	}
+	if n.set && !fn(n.prefix, n.value) {
+		return false
+	}
+	return n.son[0].walk(fn) && n.son[1].walk(fn)
+}
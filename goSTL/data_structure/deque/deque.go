@@ -3,8 +3,9 @@ package deque
 //@Title		deque
 //@Description
 //		deque双向队列容器包
-//		以切片数组的形式实现
-//		该容器可以在首部和尾部实现线性增减元素
+//		以环形缓冲区的形式实现
+//		该容器可以在首部和尾部以均摊O(1)的时间增减元素
+//		同时支持O(1)的随机访问以及在任意位置插入和删除元素
 //		通过interface实现泛型
 //		可接纳不同类型的元素
 //@author     	hlccd		2021-07-6
@@ -15,16 +16,15 @@ import (
 )
 
 //deque双向队列结构体
-//包含泛型切片和该切片的首尾指针
-//当删除节点时仅仅需要后移首指针一位即可
-//当剩余长度小于实际占用空间长度的一半时会重新规划以释放掉多余占用的空间
-//当添加节点时若未占满全部已分配空间则尾指针后移一位同时进行覆盖存放
-//当添加节点时尾指针大于已分配空间长度,则新增空间
-//首节点指针始终不能超过尾节点指针
+//包含泛型切片、首元素所在位置和元素数量
+//切片长度即为已分配的容量,且始终为0或2的幂,以便通过位运算计算环形下标
+//第i个元素存放于切片的(head+i)&(len(data)-1)位置
+//当添加节点时若容量已满则容量翻倍,并将元素按序移至新切片首部
+//当删除节点后元素数量不足容量的四分之一时容量减半以释放多余空间
 type deque struct {
-	data  []interface{} //泛型切片
-	begin int           //首节点指针
-	end   int           //尾节点指针
+	data  []interface{} //泛型切片,作为环形缓冲区使用
+	head  int           //首元素在切片中的位置
+	num   int           //元素数量
 	mutex sync.Mutex    //并发控制锁
 }
 
@@ -32,23 +32,30 @@ type deque struct {
 //存放了deque容器可使用的函数
 //对应函数介绍见下方
 type dequeer interface {
-	Iterator() *iterator.Iterator //返回一个包含deque中所有使用元素的迭代器
-	Size() (num int)              //返回该双向队列中元素的使用空间大小
-	Clear()                       //清空该双向队列
-	Empty() (b bool)              //判断该双向队列是否为空
-	PushFront(e interface{})      //将元素e添加到该队列首部
-	PushBack(e interface{})       //将元素e添加到该队列末尾
-	PopFront() (e interface{})    //将该队列首元素弹出并返回
-	PopBack() (e interface{})     //将该队列尾元素弹出并返回
-	Front() (e interface{})       //获取该队列首元素
-	Back() (e interface{})        //获取该队列尾元素
+	Iterator() *iterator.Iterator  //返回一个包含deque中所有使用元素的迭代器
+	Size() (num int)               //返回该双向队列中元素的使用空间大小
+	Clear()                        //清空该双向队列
+	Empty() (b bool)               //判断该双向队列是否为空
+	PushFront(e interface{})       //将元素e添加到该队列首部
+	PushBack(e interface{})        //将元素e添加到该队列末尾
+	PopFront() (e interface{})     //将该队列首元素弹出并返回
+	PopBack() (e interface{})      //将该队列尾元素弹出并返回
+	Front() (e interface{})        //获取该队列首元素
+	Back() (e interface{})         //获取该队列尾元素
+	At(idx int) (e interface{})    //返回该队列第idx位的元素
+	Set(idx int, e interface{})    //将该队列第idx位的元素修改为e
+	Insert(idx int, e interface{}) //在该队列第idx位插入元素e
+	Erase(idx int)                 //删除该队列第idx位的元素
 }
 
+//最小分配容量
+const minCap = 8
+
 //@title    New
 //@description
 //		新建一个deque双向队列容器并返回
 //		初始deque的切片数组为空
-//		初始deque的首尾指针均置零
+//		初始deque的首元素位置和元素数量均置零
 //@author     	hlccd		2021-07-6
 //@receiver		nil
 //@param    	nil
@@ -56,16 +63,81 @@ type dequeer interface {
 func New() *deque {
 	return &deque{
 		data:  make([]interface{}, 0, 0),
-		begin: 0,
-		end:   0,
+		head:  0,
+		num:   0,
 		mutex: sync.Mutex{},
 	}
 }
 
+//@title    pos
+//@description
+//		以deque双向队列容器做接收者
+//		返回第idx个元素在切片中的实际位置
+//@return    	d        	*deque					接收者的deque指针
+//@param    	idx			int						元素的序号
+//@return    	p			int						元素在切片中的位置
+func (d *deque) pos(idx int) (p int) {
+	return (d.head + idx) & (len(d.data) - 1)
+}
+
+//@title    resize
+//@description
+//		以deque双向队列容器做接收者
+//		重新分配容量为c的切片,并将全部元素按序移至新切片首部
+//		c必须为2的幂且不小于元素数量
+//@return    	d        	*deque					接收者的deque指针
+//@param    	c			int						新的容量
+//@return    	nil
+func (d *deque) resize(c int) {
+	data := make([]interface{}, c, c)
+	if d.num > 0 {
+		if d.head+d.num <= len(d.data) {
+			copy(data, d.data[d.head:d.head+d.num])
+		} else {
+			n := copy(data, d.data[d.head:])
+			copy(data[n:], d.data[:d.num-n])
+		}
+	}
+	d.data = data
+	d.head = 0
+}
+
+//@title    grow
+//@description
+//		以deque双向队列容器做接收者
+//		当容量已满时将容量翻倍,容量为0时分配最小容量
+//@return    	d        	*deque					接收者的deque指针
+//@param    	nil
+//@return    	nil
+func (d *deque) grow() {
+	if d.num < len(d.data) {
+		return
+	}
+	if len(d.data) == 0 {
+		d.resize(minCap)
+	} else {
+		d.resize(len(d.data) * 2)
+	}
+}
+
+//@title    shrink
+//@description
+//		以deque双向队列容器做接收者
+//		当元素数量不足容量的四分之一时将容量减半
+//		容量不会小于最小分配容量
+//@return    	d        	*deque					接收者的deque指针
+//@param    	nil
+//@return    	nil
+func (d *deque) shrink() {
+	if len(d.data) > minCap && d.num*4 < len(d.data) {
+		d.resize(len(d.data) / 2)
+	}
+}
+
 //@title    Iterator
 //@description
 //		以deque双向队列容器做接收者
-//		将deque队列容器中不使用空间释放掉
+//		将deque队列容器中的元素按序复制到新切片中
 //		返回一个包含容器中所有使用元素的迭代器
 //@auth      	hlccd		2021-07-6
 //@return    	d        	*deque					接收者的deque指针
@@ -76,10 +148,11 @@ func (d *deque) Iterator() (i *iterator.Iterator) {
 		return iterator.New(make([]interface{}, 0, 0))
 	}
 	d.mutex.Lock()
-	d.data = d.data[d.begin:d.end]
-	d.begin = 0
-	d.end = len(d.data)
-	i = iterator.New(d.data)
+	es := make([]interface{}, d.num, d.num)
+	for idx := 0; idx < d.num; idx++ {
+		es[idx] = d.data[d.pos(idx)]
+	}
+	i = iterator.New(es)
 	d.mutex.Unlock()
 	return i
 }
@@ -98,14 +171,14 @@ func (d *deque) Size() (num int) {
 	if d == nil {
 		return -1
 	}
-	return d.end - d.begin
+	return d.num
 }
 
 //@title    Clear
 //@description
 //		以deque双向队列容器做接收者
 //		将该容器中所承载的元素清空
-//		将该容器的首尾指针均置0
+//		释放已分配的空间并将首元素位置和元素数量均置0
 //@auth      	hlccd		2021-07-6
 //@return    	d        	*deque					接收者的deque指针
 //@param    	nil
@@ -115,9 +188,9 @@ func (d *deque) Clear() {
 		return
 	}
 	d.mutex.Lock()
-	d.data = d.data[0:0]
-	d.begin = 0
-	d.end = 0
+	d.data = make([]interface{}, 0, 0)
+	d.head = 0
+	d.num = 0
 	d.mutex.Unlock()
 }
 
@@ -128,9 +201,7 @@ func (d *deque) Clear() {
 //		如果含有元素则不为空,返回false
 //		如果不含有元素则说明为空,返回true
 //		如果容器不存在,返回true
-//		该判断过程通过首尾指针数值进行判断
-//		当尾指针数值等于首指针时说明不含有元素
-//		当尾指针数值大于首指针时说明含有元素
+//		该判断过程通过元素数量进行判断
 //@auth      	hlccd		2021-07-6
 //@return    	d        	*deque					接收者的deque指针
 //@param    	nil
@@ -146,8 +217,8 @@ func (d *deque) Empty() bool {
 //@description
 //		以deque双向队列容器做接收者
 //		在容器首部插入元素
-//		若首指针大于0,则对当前指针位置进行覆盖,同时首指针前移一位
-//		若首指针等于0,重新分配空间并将首指针置0尾指针设为总长度
+//		若容量已满则先扩容,随后首元素位置在环形缓冲区中前移一位并存放该元素
+//		时间复杂度为均摊O(1)
 //@auth      	hlccd		2021-07-6
 //@return    	d        	*deque					接收者的deque指针
 //@param    	e			interface{}				待插入首部的元素
@@ -157,14 +228,10 @@ func (d *deque) PushFront(e interface{}) {
 		return
 	}
 	d.mutex.Lock()
-	if d.begin > 0 {
-		d.begin--
-		d.data[d.begin] = e
-	} else {
-		d.data = append(append([]interface{}{}, e), d.data[:d.end]...)
-		d.begin = 0
-		d.end = len(d.data)
-	}
+	d.grow()
+	d.head = (d.head - 1) & (len(d.data) - 1)
+	d.data[d.head] = e
+	d.num++
 	d.mutex.Unlock()
 }
 
@@ -172,8 +239,8 @@ func (d *deque) PushFront(e interface{}) {
 //@description
 //		以deque双向队列容器做接收者
 //		在容器尾部插入元素
-//		若尾指针小于切片实际使用长度,则对当前指针位置进行覆盖,同时尾指针后移一位
-//		若尾指针等于切片实际使用长度,则新增切片长度同时使尾指针后移一位
+//		若容量已满则先扩容,随后将元素存放于末尾元素在环形缓冲区中的后一位
+//		时间复杂度为均摊O(1)
 //@auth      	hlccd		2021-07-6
 //@return    	d        	*deque					接收者的deque指针
 //@param    	e			interface{}				待插入尾部的元素
@@ -183,40 +250,36 @@ func (d *deque) PushBack(e interface{}) {
 		return
 	}
 	d.mutex.Lock()
-	if d.end < len(d.data) {
-		d.data[d.end] = e
-	} else {
-		d.data = append(d.data, e)
-	}
-	d.end++
+	d.grow()
+	d.data[d.pos(d.num)] = e
+	d.num++
 	d.mutex.Unlock()
 }
 
 //@title    PopFront
 //@description
 //		以deque双向队列容器做接收者
-//		弹出容器第一个元素,同时首指针后移一位
-//		当剩余元素数量小于容器切片实际使用空间的一半时,重新分配空间释放未使用部分
+//		弹出容器第一个元素,同时首元素位置在环形缓冲区中后移一位
+//		当剩余元素数量不足容量的四分之一时,容量减半释放未使用部分
 //		若容器为空,则不进行弹出
 //@auth      	hlccd		2021-07-6
 //@return    	d        	*deque					接收者的deque指针
 //@param    	nil
-//@return    	e 			interface{}				队尾元素
+//@return    	e 			interface{}				队首元素
 func (d *deque) PopFront() (e interface{}) {
 	if d == nil {
 		return nil
 	}
-	if d.Empty() {
-		return nil
-	}
 	d.mutex.Lock()
-	e = d.data[d.begin]
-	d.begin++
-	if d.begin*2 >= d.end {
-		d.data = d.data[d.begin:d.end]
-		d.begin = 0
-		d.end = len(d.data)
+	if d.num == 0 {
+		d.mutex.Unlock()
+		return nil
 	}
+	e = d.data[d.head]
+	d.data[d.head] = nil
+	d.head = d.pos(1)
+	d.num--
+	d.shrink()
 	d.mutex.Unlock()
 	return e
 }
@@ -224,28 +287,27 @@ func (d *deque) PopFront() (e interface{}) {
 //@title    PopBack
 //@description
 //		以deque双向队列容器做接收者
-//		弹出容器最后一个元素,同时尾指针前移一位
-//		当剩余元素数量小于容器切片实际使用空间的一半时,重新分配空间释放未使用部分
+//		弹出容器最后一个元素,同时元素数量减一
+//		当剩余元素数量不足容量的四分之一时,容量减半释放未使用部分
 //		若容器为空,则不进行弹出
 //@auth      	hlccd		2021-07-6
 //@return    	d        	*deque					接收者的deque指针
 //@param    	nil
-//@return    	e 			interface{}				队首元素
+//@return    	e 			interface{}				队尾元素
 func (d *deque) PopBack() (e interface{}) {
 	if d == nil {
 		return nil
 	}
-	if d.Empty() {
-		return nil
-	}
 	d.mutex.Lock()
-	d.end--
-	e = d.data[d.end]
-	if d.begin*2 >= d.end {
-		d.data = d.data[d.begin:d.end]
-		d.begin = 0
-		d.end = len(d.data)
+	if d.num == 0 {
+		d.mutex.Unlock()
+		return nil
 	}
+	p := d.pos(d.num - 1)
+	e = d.data[p]
+	d.data[p] = nil
+	d.num--
+	d.shrink()
 	d.mutex.Unlock()
 	return e
 }
@@ -263,11 +325,10 @@ func (d *deque) Front() (e interface{}) {
 	if d == nil {
 		return nil
 	}
-	if d.Empty() {
-		return nil
-	}
 	d.mutex.Lock()
-	e = d.data[d.begin]
+	if d.num > 0 {
+		e = d.data[d.head]
+	}
 	d.mutex.Unlock()
 	return e
 }
@@ -283,13 +344,137 @@ func (d *deque) Front() (e interface{}) {
 //@return    	e			interface{}				容器的最后一个元素
 func (d *deque) Back() (e interface{}) {
 	if d == nil {
-		return
+		return nil
 	}
-	if d.Empty() {
+	d.mutex.Lock()
+	if d.num > 0 {
+		e = d.data[d.pos(d.num-1)]
+	}
+	d.mutex.Unlock()
+	return e
+}
+
+//@title    At
+//@description
+//		以deque双向队列容器做接收者
+//		根据传入的idx寻找位于第idx位的元素
+//		当idx小于0或者不小于容器所含有的元素个数时返回nil
+//		idx从0计算,时间复杂度为O(1)
+//@return    	d        	*deque					接收者的deque指针
+//@param    	idx			int						待查找元素的位置
+//@return    	e			interface{}				从容器中查找的第idx位元素
+func (d *deque) At(idx int) (e interface{}) {
+	if d == nil {
 		return nil
 	}
 	d.mutex.Lock()
-	e = d.data[d.end-1]
+	if idx >= 0 && idx < d.num {
+		e = d.data[d.pos(idx)]
+	}
 	d.mutex.Unlock()
 	return e
 }
+
+//@title    Set
+//@description
+//		以deque双向队列容器做接收者
+//		将位于第idx位的元素修改为e
+//		当idx小于0或者不小于容器所含有的元素个数时不做修改
+//		idx从0计算,时间复杂度为O(1)
+//@return    	d        	*deque					接收者的deque指针
+//@param    	idx			int						待修改元素的位置
+//@param    	e			interface{}				修改后的元素
+//@return    	nil
+func (d *deque) Set(idx int, e interface{}) {
+	if d == nil {
+		return
+	}
+	d.mutex.Lock()
+	if idx >= 0 && idx < d.num {
+		d.data[d.pos(idx)] = e
+	}
+	d.mutex.Unlock()
+}
+
+//@title    Insert
+//@description
+//		以deque双向队列容器做接收者
+//		在容器第idx位插入元素e
+//		当idx不大于0时在首部插入,当idx不小于元素个数时在尾部插入
+//		否则比较idx前后的元素数量,仅移动较少的一侧,时间复杂度为O(min(idx,n-idx))
+//		idx从0计算
+//@return    	d        	*deque					接收者的deque指针
+//@param    	idx			int						待插入元素的位置
+//@param    	e			interface{}				待插入元素
+//@return    	nil
+func (d *deque) Insert(idx int, e interface{}) {
+	if d == nil {
+		return
+	}
+	d.mutex.Lock()
+	if idx < 0 {
+		idx = 0
+	} else if idx > d.num {
+		idx = d.num
+	}
+	d.grow()
+	if idx < d.num-idx {
+		//前半部分整体前移一位
+		d.head = (d.head - 1) & (len(d.data) - 1)
+		for i := 0; i < idx; i++ {
+			d.data[d.pos(i)] = d.data[d.pos(i+1)]
+		}
+	} else {
+		//后半部分整体后移一位
+		for i := d.num; i > idx; i-- {
+			d.data[d.pos(i)] = d.data[d.pos(i-1)]
+		}
+	}
+	d.data[d.pos(idx)] = e
+	d.num++
+	d.mutex.Unlock()
+}
+
+//@title    Erase
+//@description
+//		以deque双向队列容器做接收者
+//		删除容器第idx位的元素
+//		当idx不大于0时删除首元素,当idx不小于元素个数时删除尾元素
+//		否则比较idx前后的元素数量,仅移动较少的一侧,时间复杂度为O(min(idx,n-idx))
+//		若容器为空,则不进行删除
+//		idx从0计算
+//@return    	d        	*deque					接收者的deque指针
+//@param    	idx			int						待删除元素的位置
+//@return    	nil
+func (d *deque) Erase(idx int) {
+	if d == nil {
+		return
+	}
+	d.mutex.Lock()
+	if d.num == 0 {
+		d.mutex.Unlock()
+		return
+	}
+	if idx < 0 {
+		idx = 0
+	} else if idx >= d.num {
+		idx = d.num - 1
+	}
+	if idx < d.num-1-idx {
+		//前半部分整体后移一位
+		for i := idx; i > 0; i-- {
+			d.data[d.pos(i)] = d.data[d.pos(i-1)]
+		}
+		d.data[d.head] = nil
+		d.head = d.pos(1)
+	} else {
+		//后半部分整体前移一位
+		for i := idx; i < d.num-1; i++ {
+			d.data[d.pos(i)] = d.data[d.pos(i+1)]
+		}
+		d.data[d.pos(d.num-1)] = nil
+	}
+	d.num--
+	d.shrink()
+	d.mutex.Unlock()
+}
@@ -0,0 +1,165 @@
+package deque
+
+import (
+	"math/rand"
+	"testing"
+)
+
+//checkModel检查容器与参照切片一致,容量为2的幂,且未使用的位置已被清空
+func checkModel(t *testing.T, d *deque, model []interface{}) {
+	t.Helper()
+	if d.Size() != len(model) {
+		t.Fatalf("Size = %d, want %d", d.Size(), len(model))
+	}
+	if c := len(d.data); c&(c-1) != 0 || c < len(model) {
+		t.Fatalf("capacity %d for %d elements", c, len(model))
+	}
+	for i := range model {
+		if d.At(i) != model[i] {
+			t.Fatalf("At(%d) = %v, want %v", i, d.At(i), model[i])
+		}
+	}
+	for i := len(model); i < len(d.data); i++ {
+		if d.data[d.pos(i)] != nil {
+			t.Fatalf("unused slot %d still holds %v", d.pos(i), d.data[d.pos(i)])
+		}
+	}
+	i := 0
+	for it := d.Iterator(); it.HasNext(); it.Next() {
+		if it.Value() != model[i] {
+			t.Fatalf("Iterator[%d] = %v, want %v", i, it.Value(), model[i])
+		}
+		i++
+	}
+	if i != len(model) {
+		t.Fatalf("Iterator returned %d elements, want %d", i, len(model))
+	}
+}
+
+func TestDequeModel(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	d := New()
+	model := make([]interface{}, 0, 0)
+	for it := 0; it < 50000; it++ {
+		v := r.Intn(1000)
+		switch r.Intn(9) {
+		case 0:
+			d.PushFront(v)
+			model = append([]interface{}{v}, model...)
+		case 1:
+			d.PushBack(v)
+			model = append(model, v)
+		case 2:
+			e := d.PopFront()
+			if len(model) == 0 {
+				if e != nil {
+					t.Fatalf("PopFront on empty deque = %v", e)
+				}
+				continue
+			}
+			if e != model[0] {
+				t.Fatalf("PopFront = %v, want %v", e, model[0])
+			}
+			model = model[1:]
+		case 3:
+			e := d.PopBack()
+			if len(model) == 0 {
+				if e != nil {
+					t.Fatalf("PopBack on empty deque = %v", e)
+				}
+				continue
+			}
+			if e != model[len(model)-1] {
+				t.Fatalf("PopBack = %v, want %v", e, model[len(model)-1])
+			}
+			model = model[:len(model)-1]
+		case 4:
+			//越界的下标在首部或尾部插入
+			i := r.Intn(len(model)+3) - 1
+			d.Insert(i, v)
+			if i < 0 {
+				i = 0
+			} else if i > len(model) {
+				i = len(model)
+			}
+			model = append(model[:i], append([]interface{}{v}, model[i:]...)...)
+		case 5:
+			i := r.Intn(len(model)+3) - 1
+			d.Erase(i)
+			if len(model) == 0 {
+				continue
+			}
+			if i < 0 {
+				i = 0
+			} else if i >= len(model) {
+				i = len(model) - 1
+			}
+			model = append(model[:i:i], model[i+1:]...)
+		case 6:
+			i := r.Intn(len(model)+2) - 1
+			d.Set(i, v)
+			if i >= 0 && i < len(model) {
+				model[i] = v
+			}
+		case 7:
+			if r.Intn(100) == 0 {
+				d.Clear()
+				model = model[:0:0]
+			}
+		case 8:
+			//批量删除以触发缩容
+			for k := 0; k < 50 && len(model) > 0; k++ {
+				d.PopBack()
+				model = model[:len(model)-1]
+			}
+		}
+		if len(model) > 0 && (d.Front() != model[0] || d.Back() != model[len(model)-1]) {
+			t.Fatalf("Front/Back = %v/%v, want %v/%v", d.Front(), d.Back(), model[0], model[len(model)-1])
+		}
+		if it%101 == 0 {
+			checkModel(t, d, model)
+		}
+	}
+	checkModel(t, d, model)
+}
+
+func TestInsertEraseShift(t *testing.T) {
+	//首元素位于切片末尾附近,使两个方向的移动都跨越环形缓冲区的边界
+	build := func() (*deque, []interface{}) {
+		d := New()
+		model := make([]interface{}, 0, 0)
+		for i := 0; i < 10; i++ {
+			d.PushBack(i)
+			model = append(model, i)
+		}
+		for i := 0; i < 3; i++ {
+			d.PushFront(-1 - i)
+			model = append([]interface{}{-1 - i}, model...)
+		}
+		return d, model
+	}
+	tests := []struct {
+		name string
+		idx  int
+	}{
+		{"front", 0},
+		{"front half", 2},
+		{"middle", 6},
+		{"back half", 10},
+		{"back", 12},
+	}
+	for _, tt := range tests {
+		t.Run("insert "+tt.name, func(t *testing.T) {
+			d, model := build()
+			d.Insert(tt.idx, 100)
+			model = append(model[:tt.idx], append([]interface{}{100}, model[tt.idx:]...)...)
+			checkModel(t, d, model)
+		})
+		t.Run("erase "+tt.name, func(t *testing.T) {
+			d, model := build()
+			d.Erase(tt.idx)
+			model = append(model[:tt.idx:tt.idx], model[tt.idx+1:]...)
+			checkModel(t, d, model)
+		})
+	}
+}
@@ -0,0 +1,550 @@
+package fibHeap
+
+//@Title		fibHeap
+//@Description
+//		斐波那契堆-Fibonacci Heap
+//		以若干棵堆序树组成的可合并堆,各树的根节点以及同一节点的子节点均以循环双向链表连接
+//		插入和合并只需拼接根链表,时间复杂度为O(1)
+//		弹出堆顶时将度数相同的树两两合并,均摊时间复杂度为O(log n)
+//		减小元素时若破坏堆序则将其剪下成为新的根,并进行级联剪切,均摊时间复杂度为O(1)
+//		若使用默认比较器,顶端元素是最小元素
+import (
+	"github.com/hlccd/goSTL/utils/comparator"
+	"github.com/hlccd/goSTL/utils/iterator"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+//fibHeap斐波那契堆结构体
+//该实例存储指向最小根节点的指针及元素数量
+//比较器在创建时传入,若不传入则在插入首个元素时从默认比较器中寻找
+type fibHeap struct {
+	min   *Handle               //最小根节点指针
+	size  int                   //存储元素数量
+	cmp   comparator.Comparator //比较器
+	own   *owner                //归属标识,该斐波那契堆插入的句柄均指向它
+	mutex sync.Mutex            //并发控制锁
+}
+
+//Handle句柄结构体
+//即斐波那契堆中的节点,插入元素时返回
+//left和right为所在循环双向链表中的相邻节点,child为任意一个子节点
+//degree为子节点数量,mark记录该节点成为子节点后是否失去过子节点
+//元素被弹出后in置为false,该句柄随之失效
+type Handle struct {
+	value  interface{}    //节点中存储的元素
+	parent *Handle        //父节点
+	child  *Handle        //任意一个子节点
+	left   *Handle        //左侧相邻节点
+	right  *Handle        //右侧相邻节点
+	degree int            //子节点数量
+	mark   bool           //是否失去过子节点
+	own    unsafe.Pointer //所属堆的归属标识,类型为*owner,通过原子操作读写
+	in     bool           //该节点是否仍在堆中
+}
+
+//owner归属标识结构体
+//每个斐波那契堆持有一个归属标识,被合并时将原标识的to指向合并入的斐波那契堆的标识,并换上新的标识
+//句柄沿to找到的最终标识即为其当前所属斐波那契堆的标识,据此拒绝其他斐波那契堆的句柄
+type owner struct {
+	to unsafe.Pointer //被合并入的斐波那契堆的归属标识,类型为*owner,通过原子操作读写
+}
+
+//fibHeap斐波那契堆容器接口
+//存放了fibHeap斐波那契堆可使用的函数
+//对应函数介绍见下方
+type fibHeaper interface {
+	Iterator() (i *iterator.Iterator)               //返回包含该斐波那契堆的所有元素
+	Size() (num int)                                //返回该斐波那契堆中保存的元素个数
+	Clear()                                         //清空该斐波那契堆
+	Empty() (b bool)                                //判断该斐波那契堆是否为空
+	Push(e interface{}) (hd *Handle)                //向斐波那契堆中插入元素e并返回其句柄
+	Pop()                                           //弹出顶部元素
+	Top() (e interface{})                           //返回顶部元素
+	Merge(other *fibHeap)                           //将另一个斐波那契堆的全部元素移入该斐波那契堆
+	DecreaseKey(hd *Handle, e interface{}) (b bool) //将句柄对应的元素减小为e
+	Contains(hd *Handle) (b bool)                   //判断句柄对应的元素是否仍在堆中
+}
+
+//@title    New
+//@description
+//		新建一个fibHeap斐波那契堆容器并返回
+//		初始根链表为空
+//		若有传入的比较器,则将传入的第一个比较器设为该斐波那契堆的比较器
+//@receiver		nil
+//@param    	Cmp			 ...comparator.Comparator	fibHeap比较器集
+//@return    	fh        	*fibHeap					新建的fibHeap指针
+func New(Cmp ...comparator.Comparator) (fh *fibHeap) {
+	var cmp comparator.Comparator
+	if len(Cmp) > 0 {
+		cmp = Cmp[0]
+	}
+	return &fibHeap{
+		min:   nil,
+		size:  0,
+		cmp:   cmp,
+		own:   &owner{},
+		mutex: sync.Mutex{},
+	}
+}
+
+//@title    Value
+//@description
+//		以Handle句柄做接收者
+//		返回句柄对应的元素
+//@receiver		hd			*Handle					接受者Handle的指针
+//@param    	nil
+//@return    	e 			interface{}				句柄对应的元素
+func (hd *Handle) Value() (e interface{}) {
+	if hd == nil {
+		return nil
+	}
+	return hd.value
+}
+
+//@title    splice
+//@description
+//		以Handle句柄做接收者
+//		将以b所在的循环链表拼接到该节点所在的循环链表中
+//@receiver		hd			*Handle					接受者Handle的指针
+//@param    	b			*Handle					另一个循环链表中的节点
+//@return    	nil
+func (hd *Handle) splice(b *Handle) {
+	hr, br := hd.right, b.right
+	hd.right, br.left = br, hd
+	b.right, hr.left = hr, b
+}
+
+//@title    unlink
+//@description
+//		以Handle句柄做接收者
+//		将该节点从其所在的循环链表中移除,移除后该节点自成一个循环链表
+//@receiver		hd			*Handle					接受者Handle的指针
+//@param    	nil
+//@return    	nil
+func (hd *Handle) unlink() {
+	hd.left.right = hd.right
+	hd.right.left = hd.left
+	hd.left, hd.right = hd, hd
+}
+
+//@title    each
+//@description
+//		以Handle句柄做接收者
+//		遍历该节点所在循环链表中的全部节点及其子树,对每个节点调用fn
+//		使用栈代替递归
+//@receiver		hd			*Handle					接受者Handle的指针
+//@param    	fn			func(n *Handle)			遍历函数
+//@return    	nil
+func (hd *Handle) each(fn func(n *Handle)) {
+	if hd == nil {
+		return
+	}
+	stack := []*Handle{hd}
+	for len(stack) > 0 {
+		first := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		n := first
+		for {
+			fn(n)
+			if n.child != nil {
+				stack = append(stack, n.child)
+			}
+			n = n.right
+			if n == first {
+				break
+			}
+		}
+	}
+}
+
+//@title    Iterator
+//@description
+//		以fibHeap斐波那契堆做接收者
+//		将该斐波那契堆中所有保存的元素从最小根节点开始放入迭代器中
+//		除首个元素为顶部元素外,其余元素之间没有确定的顺序
+//@receiver		fh			*fibHeap				接受者fibHeap的指针
+//@param    	nil
+//@return    	i        	*iterator.Iterator		新建的Iterator迭代器指针
+func (fh *fibHeap) Iterator() (i *iterator.Iterator) {
+	if fh == nil {
+		return iterator.New(make([]interface{}, 0, 0))
+	}
+	fh.mutex.Lock()
+	es := make([]interface{}, 0, fh.size)
+	fh.min.each(func(n *Handle) {
+		es = append(es, n.value)
+	})
+	i = iterator.New(es)
+	fh.mutex.Unlock()
+	return i
+}
+
+//@title    Size
+//@description
+//		以fibHeap斐波那契堆做接收者
+//		返回该容器当前含有元素的数量
+//		如果容器为nil返回-1
+//@receiver		fh			*fibHeap				接受者fibHeap的指针
+//@param    	nil
+//@return    	num        	int						容器中实际使用元素所占空间大小
+func (fh *fibHeap) Size() (num int) {
+	if fh == nil {
+		return -1
+	}
+	return fh.size
+}
+
+//@title    Clear
+//@description
+//		以fibHeap斐波那契堆做接收者
+//		将该容器中所承载的元素清空,并使全部句柄失效
+//@receiver		fh			*fibHeap				接受者fibHeap的指针
+//@param    	nil
+//@return    	nil
+func (fh *fibHeap) Clear() {
+	if fh == nil {
+		return
+	}
+	fh.mutex.Lock()
+	fh.min.each(func(n *Handle) {
+		n.in = false
+	})
+	fh.min = nil
+	fh.size = 0
+	fh.mutex.Unlock()
+}
+
+//@title    Empty
+//@description
+//		以fibHeap斐波那契堆做接收者
+//		判断该斐波那契堆是否含有元素
+//		如果含有元素则不为空,返回false
+//		如果不含有元素则说明为空,返回true
+//		如果容器不存在,返回true
+//@receiver		fh			*fibHeap				接受者fibHeap的指针
+//@param    	nil
+//@return    	b			bool					该容器是空的吗?
+func (fh *fibHeap) Empty() (b bool) {
+	if fh == nil {
+		return true
+	}
+	return fh.size <= 0
+}
+
+//@title    Push
+//@description
+//		以fibHeap斐波那契堆做接收者
+//		以元素e新建一个节点并拼接到根链表中,必要时更新最小根节点
+//		返回该元素的句柄,若无法确定比较器则不插入并返回nil
+//@receiver		fh			*fibHeap				接受者fibHeap的指针
+//@param    	e			interface{}				待插入元素
+//@return    	hd			*Handle					该元素的句柄
+func (fh *fibHeap) Push(e interface{}) (hd *Handle) {
+	if fh == nil {
+		return nil
+	}
+	fh.mutex.Lock()
+	if fh.cmp == nil {
+		fh.cmp = comparator.GetCmp(e)
+	}
+	if fh.cmp == nil {
+		fh.mutex.Unlock()
+		return nil
+	}
+	hd = &Handle{value: e, own: unsafe.Pointer(fh.own), in: true}
+	hd.left, hd.right = hd, hd
+	fh.addRoot(hd)
+	fh.size++
+	fh.mutex.Unlock()
+	return hd
+}
+
+//@title    addRoot
+//@description
+//		以fibHeap斐波那契堆做接收者
+//		将以hd所在的循环链表拼接到根链表中,必要时更新最小根节点
+//		hd应为该循环链表中最小的节点
+//@receiver		fh			*fibHeap				接受者fibHeap的指针
+//@param    	hd			*Handle					待拼接的节点
+//@return    	nil
+func (fh *fibHeap) addRoot(hd *Handle) {
+	if fh.min == nil {
+		fh.min = hd
+		return
+	}
+	fh.min.splice(hd)
+	if fh.cmp(hd.value, fh.min.value) < 0 {
+		fh.min = hd
+	}
+}
+
+//@title    Pop
+//@description
+//		以fibHeap斐波那契堆做接收者
+//		删除最小根节点,将其子节点全部移入根链表
+//		随后将度数相同的根两两合并,直至所有根的度数互不相同,并找出新的最小根节点
+//@receiver		fh			*fibHeap				接受者fibHeap的指针
+//@param    	nil
+//@return    	nil
+func (fh *fibHeap) Pop() {
+	if fh == nil {
+		return
+	}
+	fh.mutex.Lock()
+	if fh.min == nil {
+		fh.mutex.Unlock()
+		return
+	}
+	z := fh.min
+	//子节点移入根链表
+	if c := z.child; c != nil {
+		for n := c; ; {
+			n.parent, n.mark = nil, false
+			n = n.right
+			if n == c {
+				break
+			}
+		}
+		z.splice(c)
+		z.child = nil
+	}
+	next := z.right
+	z.unlink()
+	z.in, z.degree = false, 0
+	fh.size--
+	if next == z {
+		fh.min = nil
+	} else {
+		fh.consolidate(next)
+	}
+	fh.mutex.Unlock()
+}
+
+//@title    consolidate
+//@description
+//		以fibHeap斐波那契堆做接收者
+//		将根链表中度数相同的树两两合并,较大的根成为较小的根的子节点
+//		合并结束后重新构建根链表并找出最小根节点
+//@receiver		fh			*fibHeap				接受者fibHeap的指针
+//@param    	start		*Handle					根链表中的任意节点
+//@return    	nil
+func (fh *fibHeap) consolidate(start *Handle) {
+	roots := make([]*Handle, 0, 0)
+	for n := start; ; {
+		roots = append(roots, n)
+		n = n.right
+		if n == start {
+			break
+		}
+	}
+	byDegree := make([]*Handle, 0, 0)
+	for _, x := range roots {
+		x.left, x.right = x, x
+		for {
+			for len(byDegree) <= x.degree {
+				byDegree = append(byDegree, nil)
+			}
+			y := byDegree[x.degree]
+			if y == nil {
+				break
+			}
+			byDegree[x.degree] = nil
+			if fh.cmp(y.value, x.value) < 0 {
+				x, y = y, x
+			}
+			//y成为x的子节点
+			y.parent, y.mark = x, false
+			if x.child == nil {
+				x.child = y
+			} else {
+				x.child.splice(y)
+			}
+			x.degree++
+		}
+		byDegree[x.degree] = x
+	}
+	fh.min = nil
+	for _, x := range byDegree {
+		if x != nil {
+			fh.addRoot(x)
+		}
+	}
+}
+
+//@title    Top
+//@description
+//		以fibHeap斐波那契堆做接收者
+//		返回该斐波那契堆的顶部元素
+//		当该斐波那契堆不存在或为空时返回nil
+//@receiver		fh			*fibHeap				接受者fibHeap的指针
+//@param    	nil
+//@return    	e 			interface{}				该斐波那契堆的顶部元素
+func (fh *fibHeap) Top() (e interface{}) {
+	if fh == nil {
+		return nil
+	}
+	fh.mutex.Lock()
+	if fh.min != nil {
+		e = fh.min.value
+	}
+	fh.mutex.Unlock()
+	return e
+}
+
+//@title    lockPair
+//@description
+//		按地址顺序对斐波那契堆a和b加锁
+//		两个斐波那契堆相互合并时加锁顺序一致,不会死锁
+//@receiver		nil
+//@param    	a			*fibHeap				待加锁的斐波那契堆
+//@param    	b			*fibHeap				待加锁的斐波那契堆
+//@return    	nil
+func lockPair(a, b *fibHeap) {
+	if uintptr(unsafe.Pointer(a)) > uintptr(unsafe.Pointer(b)) {
+		a, b = b, a
+	}
+	a.mutex.Lock()
+	b.mutex.Lock()
+}
+
+//@title    Merge
+//@description
+//		以fibHeap斐波那契堆做接收者
+//		将另一个斐波那契堆的全部元素移入该斐波那契堆,移入后另一个斐波那契堆为空
+//		只需拼接两个根链表,时间复杂度为O(1)
+//		另一个斐波那契堆中元素的句柄在该斐波那契堆中依然有效,另一个斐波那契堆换上新的归属标识后不再接受这些句柄
+//		合并时同时持有两个斐波那契堆的锁,归属标识通过原子操作读写,合并期间其他斐波那契堆判断这些句柄也不会产生竞争
+//		若两者为同一个斐波那契堆则不做合并
+//@receiver		fh			*fibHeap				接受者fibHeap的指针
+//@param    	other		*fibHeap				待合并的斐波那契堆
+//@return    	nil
+func (fh *fibHeap) Merge(other *fibHeap) {
+	if fh == nil || other == nil || fh == other {
+		return
+	}
+	//同时持有两个斐波那契堆的锁,使归属标识在转移过程中不会被另一个斐波那契堆访问
+	lockPair(fh, other)
+	min, size := other.min, other.size
+	if min == nil {
+		other.mutex.Unlock()
+		fh.mutex.Unlock()
+		return
+	}
+	other.min, other.size = nil, 0
+	atomic.StorePointer(&other.own.to, unsafe.Pointer(fh.own))
+	other.own = &owner{}
+	if fh.cmp == nil {
+		fh.cmp = other.cmp
+	}
+	fh.addRoot(min)
+	fh.size += size
+	other.mutex.Unlock()
+	fh.mutex.Unlock()
+}
+
+//@title    DecreaseKey
+//@description
+//		以fibHeap斐波那契堆做接收者
+//		将句柄对应的元素减小为e
+//		若减小后小于其父节点,则将其剪下移入根链表,并对父节点进行级联剪切
+//		级联剪切:若父节点已失去过子节点则将其也剪下并继续向上,否则标记该父节点
+//		句柄已失效或e大于原元素时不做修改并返回false
+//		句柄需属于该斐波那契堆或已合并入该斐波那契堆的斐波那契堆,否则不做修改并返回false
+//@receiver		fh			*fibHeap				接受者fibHeap的指针
+//@param    	hd			*Handle					元素的句柄
+//@param    	e			interface{}				新元素
+//@return    	b			bool					是否修改成功?
+func (fh *fibHeap) DecreaseKey(hd *Handle, e interface{}) (b bool) {
+	if fh == nil || hd == nil {
+		return false
+	}
+	fh.mutex.Lock()
+	if !hd.in || !fh.owns(hd) || fh.cmp(e, hd.value) > 0 {
+		fh.mutex.Unlock()
+		return false
+	}
+	hd.value = e
+	if p := hd.parent; p != nil && fh.cmp(hd.value, p.value) < 0 {
+		fh.cut(hd)
+		for p.parent != nil {
+			if !p.mark {
+				p.mark = true
+				break
+			}
+			q := p.parent
+			fh.cut(p)
+			p = q
+		}
+	}
+	if fh.cmp(hd.value, fh.min.value) < 0 {
+		fh.min = hd
+	}
+	fh.mutex.Unlock()
+	return true
+}
+
+//@title    cut
+//@description
+//		以fibHeap斐波那契堆做接收者
+//		将节点hd从其父节点的子链表中剪下并移入根链表
+//@receiver		fh			*fibHeap				接受者fibHeap的指针
+//@param    	hd			*Handle					待剪下的节点
+//@return    	nil
+func (fh *fibHeap) cut(hd *Handle) {
+	p := hd.parent
+	if p.child == hd {
+		if hd.right == hd {
+			p.child = nil
+		} else {
+			p.child = hd.right
+		}
+	}
+	hd.unlink()
+	p.degree--
+	hd.parent, hd.mark = nil, false
+	fh.min.splice(hd)
+}
+
+//@title    owns
+//@description
+//		以fibHeap斐波那契堆做接收者
+//		判断句柄是否属于该斐波那契堆,即由该斐波那契堆或已合并入该斐波那契堆的斐波那契堆插入
+//		沿归属标识的to找到最终标识,途中将经过的标识改为指向其后第二个标识,并将最终标识记回句柄以缩短后续查找
+//		标识和句柄中的指针均通过原子操作读写,且只会改为指向同一链上更靠后的标识,其他斐波那契堆同时判断该句柄时不会出错
+//		需在持有锁时调用
+//@receiver		fh			*fibHeap				接受者fibHeap的指针
+//@param    	hd			*Handle					元素的句柄
+//@return    	b			bool					该句柄属于该斐波那契堆?
+func (fh *fibHeap) owns(hd *Handle) (b bool) {
+	o := (*owner)(atomic.LoadPointer(&hd.own))
+	if o == nil {
+		return false
+	}
+	for n := (*owner)(atomic.LoadPointer(&o.to)); n != nil; n = (*owner)(atomic.LoadPointer(&o.to)) {
+		if nn := atomic.LoadPointer(&n.to); nn != nil {
+			atomic.StorePointer(&o.to, nn)
+		}
+		o = n
+	}
+	atomic.StorePointer(&hd.own, unsafe.Pointer(o))
+	return o == fh.own
+}
+
+//@title    Contains
+//@description
+//		以fibHeap斐波那契堆做接收者
+//		判断句柄对应的元素是否仍在该堆中,即属于该斐波那契堆且尚未被弹出
+//@receiver		fh			*fibHeap				接受者fibHeap的指针
+//@param    	hd			*Handle					元素的句柄
+//@return    	b			bool					该元素仍在堆中?
+func (fh *fibHeap) Contains(hd *Handle) (b bool) {
+	if fh == nil || hd == nil {
+		return false
+	}
+	fh.mutex.Lock()
+	b = hd.in && fh.owns(hd)
+	fh.mutex.Unlock()
+	return b
+}
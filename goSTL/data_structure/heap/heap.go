@@ -13,29 +13,58 @@ package heap
 import (
 	"github.com/hlccd/goSTL/utils/comparator"
 	"github.com/hlccd/goSTL/utils/iterator"
+	"sort"
 	"sync"
+	"unsafe"
 )
 
 //heap堆集合结构体
 //包含泛型切片和比较器
 //增删节点后会使用比较器保持该切片数组的有序性
+//handles与data一一对应,交换元素时同时交换句柄并更新句柄中记录的位置
+//limit大于0时为容量有限的堆,元素数量不会超过limit
+//arity为堆的叉数,下标为p的节点的子节点下标为arity*p+1至arity*p+arity
 type heap struct {
-	data []interface{}         //泛型切片
-	cmp  comparator.Comparator //该堆的比较器
+	data    []interface{}         //泛型切片
+	handles []*Handle             //元素对应的句柄
+	cmp     comparator.Comparator //该堆的比较器
+	limit   int                   //容量上限,为0时不限制
+	arity   int                   //堆的叉数,默认为2
 	mutex   sync.Mutex            //并发控制锁
 }
 
+//Handle句柄结构体
+//插入元素时返回,记录该元素所在的堆及其在堆中的位置
+//元素被弹出或删除后idx置为-1,该句柄随之失效
+type Handle struct {
+	h   *heap //句柄所属的堆
+	idx int   //元素在堆中的位置
+}
+
+//Option堆的配置项
+//在新建堆时传入,用于修改堆的默认配置
+type Option func(h *heap)
+
 //heap堆容器接口
 //存放了heap容器可使用的函数
 //对应函数介绍见下方
 type heaper interface {
-	Iterator() (i *iterator.Iterator) //返回一个包含heap容器中所有使用元素的迭代器
-	Size() (num int)                  //返回该容器存储的元素数量
-	Clear()                           //清空该容器
-	Empty() (b bool)                  //判断该容器是否为空
-	Push(e interface{})               //将元素e插入该容器
-	Pop()                             //弹出顶部元素
-	Top() (e interface{})             //返回顶部元素
+	Iterator() (i *iterator.Iterator)                          //返回一个包含heap容器中所有使用元素的迭代器
+	Size() (num int)                                           //返回该容器存储的元素数量
+	Clear()                                                    //清空该容器
+	Empty() (b bool)                                           //判断该容器是否为空
+	Push(e interface{}) (hd *Handle)                           //将元素e插入该容器并返回其句柄
+	Pop()                                                      //弹出顶部元素
+	Top() (e interface{})                                      //返回顶部元素
+	Update(hd *Handle, e interface{}) (b bool)                 //将句柄对应的元素修改为e
+	Remove(hd *Handle) (e interface{})                         //删除句柄对应的元素并返回
+	Contains(hd *Handle) (b bool)                              //判断句柄对应的元素是否仍在该容器中
+	PushAll(es ...interface{})                                 //将全部元素插入该容器
+	Merge(other *heap)                                         //将另一个堆的全部元素移入该容器
+	PopN(k int) (es []interface{})                             //按序弹出顶部的k个元素
+	Drain() (es []interface{})                                 //按序弹出全部元素
+	PushEvict(e interface{}) (hd *Handle, evicted interface{}) //插入元素e并返回其句柄及被淘汰的元素
+	Sorted() (es []interface{})                                //返回由大到小排列的全部元素
 }
 
 //@title    New
@@ -55,12 +84,114 @@ func New(cmps ...comparator.Comparator) (h *heap) {
 		cmp = cmps[0]
 	}
 	return &heap{
-		data: make([]interface{}, 0, 0),
-		cmp:  cmp,
+		data:    make([]interface{}, 0, 0),
+		handles: make([]*Handle, 0, 0),
+		cmp:     cmp,
+		limit:   0,
+		arity:   2,
 		mutex:   sync.Mutex{},
 	}
 }
 
+//@title    NewWithOptions
+//@description
+//		以传入的比较器和配置项新建一个heap堆容器并返回,如NewWithOptions(cmp, WithArity(4))
+//		cmp为nil时在插入首个元素时从默认比较器中寻找
+//		配置项按传入顺序依次生效,为nil的配置项将被跳过
+//@receiver		nil
+//@param    	cmp			comparator.Comparator		heap的比较器
+//@param    	opts		...Option					heap的配置项
+//@return    	h        	*heap						新建的heap指针
+func NewWithOptions(cmp comparator.Comparator, opts ...Option) (h *heap) {
+	h = New(cmp)
+	for i := 0; i < len(opts); i++ {
+		if opts[i] != nil {
+			opts[i](h)
+		}
+	}
+	return h
+}
+
+//@title    WithArity
+//@description
+//		返回设置堆的叉数的配置项,在NewWithOptions中使用
+//		叉数越大树高越低,上升更快而下沉时需比较的子节点更多
+//		对于比较代价较低的大堆,4叉或8叉通常比2叉具有更好的缓存局部性
+//		d小于2时不做修改,依然为2叉堆
+//@receiver		nil
+//@param    	d			int							堆的叉数
+//@return    	opt        	Option						对应的配置项
+func WithArity(d int) (opt Option) {
+	return func(h *heap) {
+		if d >= 2 {
+			h.arity = d
+		}
+	}
+}
+
+//@title    NewBounded
+//@description
+//		新建一个容量为n的heap堆容器并返回
+//		堆满时插入的元素只有在大于堆顶元素时才会替换堆顶,被替换的堆顶元素即被淘汰
+//		由于堆顶为最小元素,故该堆始终保存已插入元素中最大的n个,可用于求数据流的前n大元素
+//		若需保存最小的n个,传入相反的比较器即可
+//		n不大于0时与New相同,不限制容量
+//@receiver		nil
+//@param    	n			int							容量上限
+//@param    	Cmp			...comparator.Comparator	heap的比较器集
+//@return    	h        	*heap						新建的heap指针
+func NewBounded(n int, cmps ...comparator.Comparator) (h *heap) {
+	h = New(cmps...)
+	if n > 0 {
+		h.limit = n
+	}
+	return h
+}
+
+//@title    FromSlice
+//@description
+//		以传入的切片新建一个heap堆容器并返回
+//		复制切片中的元素后自最后一个非叶子节点起依次向前进行下沉(Floyd建堆法)
+//		时间复杂度为O(n),优于逐个插入的O(n log n)
+//		如果有传入比较器,则将传入的第一个比较器设为该堆的比较器,否则以首个元素寻找默认比较器
+//		若无法确定比较器则返回空堆
+//@receiver		nil
+//@param    	data		[]interface{}				初始元素
+//@param    	Cmp			...comparator.Comparator	heap的比较器集
+//@return    	h        	*heap						新建的heap指针
+func FromSlice(data []interface{}, cmps ...comparator.Comparator) (h *heap) {
+	h = New(cmps...)
+	if len(data) == 0 {
+		return h
+	}
+	if h.cmp == nil {
+		h.cmp = comparator.GetCmp(data[0])
+	}
+	if h.cmp == nil {
+		return h
+	}
+	h.data = append(h.data, data...)
+	h.handles = make([]*Handle, len(data), len(data))
+	for i := 0; i < len(data); i++ {
+		h.handles[i] = &Handle{h: h, idx: i}
+	}
+	h.heapify()
+	return h
+}
+
+//@title    heapify
+//@description
+//		以heap容器做接收者
+//		自最后一个非叶子节点起依次向前对每个节点进行下沉,使整个切片成为堆
+//@receiver		h			*heap					接受者heap的指针
+//@param    	nil
+//@return    	nil
+func (h *heap) heapify() {
+	for p := (len(h.data) - 2) / h.arity; p >= 0; p-- {
+		h.down(p)
+	}
+}
+
 //@title    Iterator
 //@description
 //		以heap容器做接收者
@@ -74,7 +205,7 @@ func (h *heap) Iterator() (i *iterator.Iterator) {
 		return iterator.New(make([]interface{}, 0, 0))
 	}
 	h.mutex.Lock()
-	i=iterator.New(h.data)
+	i = iterator.New(h.data)
 	h.mutex.Unlock()
 	return i
 }
@@ -108,7 +239,12 @@ func (h *heap) Clear() {
 		return
 	}
 	h.mutex.Lock()
+	for i := 0; i < len(h.handles); i++ {
+		h.handles[i].idx = -1
+		h.handles[i] = nil
+	}
 	h.data = h.data[0:0]
+	h.handles = h.handles[0:0]
 	h.mutex.Unlock()
 }
 
@@ -135,13 +271,33 @@ func (h *heap) Empty() bool {
 //@description
 //		以heap容器做接收者
 //		在该堆中插入元素e,利用比较器和交换使得堆保持相对有序状态
+//		返回该元素的句柄,可用于之后修改或删除该元素
+//		若无法确定比较器则不插入并返回nil
+//		对于容量已满的有限堆,插入规则见PushEvict,未被插入时返回nil
 //@author     	hlccd		2021-07-10
 //@receiver		h			*heap					接受者heap的指针
 //@param    	e			interface{}				待插入元素
-//@return    	nil
-func (h *heap) Push(e interface{}) {
+//@return    	hd			*Handle					该元素的句柄
+func (h *heap) Push(e interface{}) (hd *Handle) {
+	hd, _ = h.PushEvict(e)
+	return hd
+}
+
+//@title    PushEvict
+//@description
+//		以heap容器做接收者
+//		在该堆中插入元素e,返回该元素的句柄以及因此被淘汰的元素
+//		若该堆为容量已满的有限堆,则仅当e大于堆顶元素时用e替换堆顶并下沉,被替换的堆顶元素即被淘汰,其句柄失效
+//		否则e不被插入,此时返回的句柄为nil,被淘汰的元素即为e本身
+//		未满或无容量限制时与Push相同,被淘汰的元素为nil
+//		若无法确定比较器则不插入,返回的句柄和被淘汰的元素均为nil
+//@receiver		h			*heap					接受者heap的指针
+//@param    	e			interface{}				待插入元素
+//@return    	hd			*Handle					该元素的句柄
+//@return    	evicted		interface{}				被淘汰的元素
+func (h *heap) PushEvict(e interface{}) (hd *Handle, evicted interface{}) {
 	if h == nil {
-		return
+		return nil, nil
 	}
 	h.mutex.Lock()
 	if h.cmp == nil {
@@ -149,30 +305,80 @@ func (h *heap) Push(e interface{}) {
 	}
 	if h.cmp == nil {
 		h.mutex.Unlock()
-		return
+		return nil, nil
+	}
+	if h.limit > 0 && len(h.data) >= h.limit {
+		if h.cmp(e, h.data[0]) <= 0 {
+			h.mutex.Unlock()
+			return nil, e
+		}
+		evicted = h.data[0]
+		h.handles[0].idx = -1
+		hd = &Handle{h: h, idx: 0}
+		h.data[0], h.handles[0] = e, hd
+		h.down(0)
+		h.mutex.Unlock()
+		return hd, evicted
 	}
+	hd = &Handle{h: h, idx: len(h.data)}
 	h.data = append(h.data, e)
+	h.handles = append(h.handles, hd)
 	h.up(len(h.data) - 1)
 	h.mutex.Unlock()
+	return hd, nil
+}
+
+//@title    shrink
+//@description
+//		以heap容器做接收者
+//		对于有限堆,不断弹出堆顶直至元素数量不超过容量上限
+//@receiver		h			*heap					接受者heap的指针
+//@param    	nil
+//@return    	nil
+func (h *heap) shrink() {
+	for h.limit > 0 && len(h.data) > h.limit {
+		h.removeAt(0)
+	}
+}
+
+//@title    swap
+//@description
+//		以heap容器做接收者
+//		交换位置i和位置j的元素及其句柄,并更新句柄中记录的位置
+//@receiver		h			*heap					接受者heap的指针
+//@param    	i			int						位置i
+//@param    	j			int						位置j
+//@return    	nil
+func (h *heap) swap(i, j int) {
+	h.data[i], h.data[j] = h.data[j], h.data[i]
+	h.handles[i], h.handles[j] = h.handles[j], h.handles[i]
+	h.handles[i].idx = i
+	h.handles[j].idx = j
 }
 
 //@title    up
 //@description
 //		以heap容器做接收者
-//		判断待上升节点与其父节点的大小关系以确定是否进行递归上升
+//		判断待上升节点与其父节点的大小关系以确定是否继续上升
 //		从而保证父节点必然都大于或都小于子节点
+//		上升过程中父节点依次下移,待上升节点只在最终位置写入一次
 //@author     	hlccd		2021-07-10
 //@receiver		h			*heap					接受者heap的指针
 //@param    	p			int						待上升节点的位置
 //@return    	nil
 func (h *heap) up(p int) {
-	if p == 0 {
-		return
-	}
-	if h.cmp(h.data[(p-1)/2], h.data[p]) > 0 {
-		h.data[p], h.data[(p-1)/2] = h.data[(p-1)/2], h.data[p]
-		h.up((p - 1) / 2)
+	e, hd := h.data[p], h.handles[p]
+	for p > 0 {
+		q := (p - 1) / h.arity
+		if h.cmp(h.data[q], e) <= 0 {
+			break
+		}
+		h.data[p], h.handles[p] = h.data[q], h.handles[q]
+		h.handles[p].idx = p
+		p = q
 	}
+	h.data[p], h.handles[p] = e, hd
+	hd.idx = p
 }
 
 //@title    Pop
@@ -192,37 +398,80 @@ func (h *heap) Pop() {
 		h.mutex.Unlock()
 		return
 	}
-	h.data[0] = h.data[h.Size()-1]
-	h.data = h.data[:h.Size()-1]
-	if h.Empty() {
-		h.mutex.Unlock()
-		return
-	}
-	h.down(0)
+	h.removeAt(0)
 	h.mutex.Unlock()
 }
 
+//@title    removeAt
+//@description
+//		以heap容器做接收者
+//		删除位置p的元素并使其句柄失效
+//		将末尾元素移至位置p后,根据其与父节点的大小关系进行上升或下沉
+//@receiver		h			*heap					接受者heap的指针
+//@param    	p			int						待删除元素的位置
+//@return    	e			interface{}				被删除的元素
+func (h *heap) removeAt(p int) (e interface{}) {
+	e = h.data[p]
+	last := len(h.data) - 1
+	h.swap(p, last)
+	h.handles[last].idx = -1
+	h.data[last], h.handles[last] = nil, nil
+	h.data, h.handles = h.data[:last], h.handles[:last]
+	if p < last {
+		h.fix(p)
+	}
+	return e
+}
+
+//@title    fix
+//@description
+//		以heap容器做接收者
+//		位置p的元素被修改后重新调整其位置
+//		若其比父节点更靠近堆顶则上升,否则下沉
+//@receiver		h			*heap					接受者heap的指针
+//@param    	p			int						被修改元素的位置
+//@return    	nil
+func (h *heap) fix(p int) {
+	if p > 0 && h.cmp(h.data[(p-1)/h.arity], h.data[p]) > 0 {
+		h.up(p)
+	} else {
+		h.down(p)
+	}
+}
+
 //@title    down
 //@description
 //		以heap容器做接收者
-//		判断待下沉节点与其左右子节点的大小关系以确定是否进行递归上升
+//		找出待下沉节点的子节点中最靠近堆顶者,判断二者的大小关系以确定是否继续下沉
 //		从而保证父节点必然都大于或都小于子节点
+//		下沉过程中子节点依次上移,待下沉节点只在最终位置写入一次
 //@author     	hlccd		2021-07-10
 //@receiver		h			*heap					接受者heap的指针
 //@param    	p			int						待下沉节点的位置
 //@return    	nil
 func (h *heap) down(p int) {
-	q := p
-	if 2*p+1 <= h.Size()-1 && h.cmp(h.data[p], h.data[2*p+1]) > 0 {
-		q = 2*p + 1
-	}
-	if 2*p+2 < h.Size()-1 && h.cmp(h.data[q], h.data[2*p+2]) > 0 {
-		q = 2*p + 2
-	}
-	if p != q {
-		h.data[p], h.data[q] = h.data[q], h.data[p]
-		h.down(q)
+	n := len(h.data)
+	e, hd := h.data[p], h.handles[p]
+	for {
+		c := h.arity*p + 1
+		if c >= n {
+			break
+		}
+		q := c
+		for i := c + 1; i < c+h.arity && i < n; i++ {
+			if h.cmp(h.data[i], h.data[q]) < 0 {
+				q = i
+			}
+		}
+		if h.cmp(e, h.data[q]) <= 0 {
+			break
+		}
+		h.data[p], h.handles[p] = h.data[q], h.handles[q]
+		h.handles[p].idx = p
+		p = q
 	}
+	h.data[p], h.handles[p] = e, hd
+	hd.idx = p
 }
 
 //@title    Top
@@ -242,7 +491,240 @@ func (h *heap) Top() (e interface{}) {
 		return nil
 	}
 	h.mutex.Lock()
-	e=h.data[0]
+	e = h.data[0]
+	h.mutex.Unlock()
+	return e
+}
+
+//@title    Contains
+//@description
+//		以heap容器做接收者
+//		判断句柄对应的元素是否仍在该堆中
+//		句柄为nil、属于其他堆或其元素已被弹出或删除时返回false
+//@receiver		h			*heap					接受者heap的指针
+//@param    	hd			*Handle					元素的句柄
+//@return    	b			bool					该元素仍在堆中?
+func (h *heap) Contains(hd *Handle) (b bool) {
+	if h == nil || hd == nil {
+		return false
+	}
+	h.mutex.Lock()
+	b = h.contains(hd)
+	h.mutex.Unlock()
+	return b
+}
+
+//@title    contains
+//@description
+//		以heap容器做接收者
+//		判断句柄对应的元素是否仍在该堆中,调用时需持有锁
+//@receiver		h			*heap					接受者heap的指针
+//@param    	hd			*Handle					元素的句柄
+//@return    	b			bool					该元素仍在堆中?
+func (h *heap) contains(hd *Handle) (b bool) {
+	return hd.h == h && hd.idx >= 0 && hd.idx < len(h.handles) && h.handles[hd.idx] == hd
+}
+
+//@title    Update
+//@description
+//		以heap容器做接收者
+//		将句柄对应的元素修改为e,并根据新元素上升或下沉以保持堆的有序性
+//		修改后句柄依然有效,时间复杂度为O(log n)
+//		可用于Dijkstra等算法中的DecreaseKey操作
+//		句柄无效时不做修改并返回false
+//@receiver		h			*heap					接受者heap的指针
+//@param    	hd			*Handle					元素的句柄
+//@param    	e			interface{}				新元素
+//@return    	b			bool					是否修改成功?
+func (h *heap) Update(hd *Handle, e interface{}) (b bool) {
+	if h == nil || hd == nil {
+		return false
+	}
+	h.mutex.Lock()
+	if !h.contains(hd) {
+		h.mutex.Unlock()
+		return false
+	}
+	h.data[hd.idx] = e
+	h.fix(hd.idx)
+	h.mutex.Unlock()
+	return true
+}
+
+//@title    Remove
+//@description
+//		以heap容器做接收者
+//		删除句柄对应的元素并返回,删除后该句柄失效
+//		时间复杂度为O(log n)
+//		句柄无效时返回nil
+//@receiver		h			*heap					接受者heap的指针
+//@param    	hd			*Handle					元素的句柄
+//@return    	e			interface{}				被删除的元素
+func (h *heap) Remove(hd *Handle) (e interface{}) {
+	if h == nil || hd == nil {
+		return nil
+	}
+	h.mutex.Lock()
+	if !h.contains(hd) {
+		h.mutex.Unlock()
+		return nil
+	}
+	e = h.removeAt(hd.idx)
 	h.mutex.Unlock()
 	return e
 }
+
+//@title    PushAll
+//@description
+//		以heap容器做接收者
+//		将传入的全部元素插入该堆,只需加锁一次
+//		若插入的元素数量不少于已有元素数量,则追加后整体重新建堆,否则逐个上升
+//		对于有限堆,插入后依次淘汰堆顶直至不超过容量上限
+//		若无法确定比较器则不插入
+//@receiver		h			*heap					接受者heap的指针
+//@param    	es			...interface{}			待插入元素
+//@return    	nil
+func (h *heap) PushAll(es ...interface{}) {
+	if h == nil || len(es) == 0 {
+		return
+	}
+	h.mutex.Lock()
+	if h.cmp == nil {
+		h.cmp = comparator.GetCmp(es[0])
+	}
+	if h.cmp == nil {
+		h.mutex.Unlock()
+		return
+	}
+	n := len(h.data)
+	for i := 0; i < len(es); i++ {
+		h.data = append(h.data, es[i])
+		h.handles = append(h.handles, &Handle{h: h, idx: n + i})
+	}
+	if len(es) >= n {
+		h.heapify()
+	} else {
+		for i := n; i < len(h.data); i++ {
+			h.up(i)
+		}
+	}
+	h.shrink()
+	h.mutex.Unlock()
+}
+
+//@title    lockPair
+//@description
+//		按地址顺序对堆a和b加锁
+//		两个堆相互合并时加锁顺序一致,不会死锁
+//@receiver		nil
+//@param    	a			*heap					待加锁的堆
+//@param    	b			*heap					待加锁的堆
+//@return    	nil
+func lockPair(a, b *heap) {
+	if uintptr(unsafe.Pointer(a)) > uintptr(unsafe.Pointer(b)) {
+		a, b = b, a
+	}
+	a.mutex.Lock()
+	b.mutex.Lock()
+}
+
+//@title    Merge
+//@description
+//		以heap容器做接收者
+//		将另一个堆的全部元素移入该堆,移入后另一个堆为空
+//		另一个堆中元素的句柄转移至该堆,依然有效
+//		合并后整体重新建堆,时间复杂度为O(n+m)
+//		对于有限堆,合并后依次淘汰堆顶直至不超过容量上限
+//		合并时同时持有两个堆的锁,但句柄不应在合并期间交给这两个堆之外的其他堆使用
+//		若两堆为同一个堆则不做合并
+//@receiver		h			*heap					接受者heap的指针
+//@param    	other		*heap					待合并的堆
+//@return    	nil
+func (h *heap) Merge(other *heap) {
+	if h == nil || other == nil || h == other {
+		return
+	}
+	//同时持有两个堆的锁,使句柄在转移过程中不会被另一个堆访问
+	lockPair(h, other)
+	data, handles := other.data, other.handles
+	other.data, other.handles = make([]interface{}, 0, 0), make([]*Handle, 0, 0)
+	if len(data) == 0 {
+		other.mutex.Unlock()
+		h.mutex.Unlock()
+		return
+	}
+	if h.cmp == nil {
+		h.cmp = other.cmp
+	}
+	n := len(h.data)
+	h.data = append(h.data, data...)
+	h.handles = append(h.handles, handles...)
+	for i := n; i < len(h.handles); i++ {
+		h.handles[i].h, h.handles[i].idx = h, i
+	}
+	h.heapify()
+	h.shrink()
+	other.mutex.Unlock()
+	h.mutex.Unlock()
+}
+
+//@title    PopN
+//@description
+//		以heap容器做接收者
+//		依次弹出顶部的k个元素并按弹出顺序返回
+//		若元素不足k个则弹出全部元素
+//@receiver		h			*heap					接受者heap的指针
+//@param    	k			int						弹出的数量
+//@return    	es			[]interface{}			弹出的元素
+func (h *heap) PopN(k int) (es []interface{}) {
+	es = make([]interface{}, 0, 0)
+	if h == nil || k <= 0 {
+		return es
+	}
+	h.mutex.Lock()
+	for len(es) < k && len(h.data) > 0 {
+		es = append(es, h.removeAt(0))
+	}
+	h.mutex.Unlock()
+	return es
+}
+
+//@title    Drain
+//@description
+//		以heap容器做接收者
+//		依次弹出全部元素并按弹出顺序返回,即堆排序的结果
+//		弹出后该堆为空
+//@receiver		h			*heap					接受者heap的指针
+//@param    	nil
+//@return    	es			[]interface{}			弹出的元素
+func (h *heap) Drain() (es []interface{}) {
+	if h == nil {
+		return make([]interface{}, 0, 0)
+	}
+	return h.PopN(h.Size())
+}
+
+//@title    Sorted
+//@description
+//		以heap容器做接收者
+//		返回该堆中全部元素由大到小排列的结果,即与弹出顺序相反
+//		对于以NewBounded创建的有限堆,即为所保存的前n大元素由大到小排列
+//		不改变该堆
+//@receiver		h			*heap					接受者heap的指针
+//@param    	nil
+//@return    	es			[]interface{}			排列后的元素
+func (h *heap) Sorted() (es []interface{}) {
+	if h == nil {
+		return make([]interface{}, 0, 0)
+	}
+	h.mutex.Lock()
+	es = append(make([]interface{}, 0, len(h.data)), h.data...)
+	cmp := h.cmp
+	h.mutex.Unlock()
+	if len(es) > 1 {
+		sort.SliceStable(es, func(i, j int) bool {
+			return cmp(es[i], es[j]) > 0
+		})
+	}
+	return es
+}
@@ -0,0 +1,66 @@
+package heap
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func intCmp(a, b interface{}) int {
+	x, y := a.(int), b.(int)
+	if x < y {
+		return -1
+	}
+	if x > y {
+		return 1
+	}
+	return 0
+}
+
+func TestArity(t *testing.T) {
+	for _, d := range []int{1, 2, 3, 4, 8} {
+		r := rand.New(rand.NewSource(int64(d)))
+		h := NewWithOptions(intCmp, WithArity(d))
+		want := map[int]int{}
+		for i := 0; i < 2000; i++ {
+			if r.Intn(3) > 0 || h.Empty() {
+				v := r.Intn(500)
+				h.Push(v)
+				want[v]++
+				continue
+			}
+			top := h.Top().(int)
+			for v, n := range want {
+				if n > 0 && v < top {
+					t.Fatalf("arity %d: top %d but %d is smaller", d, top, v)
+				}
+			}
+			h.Pop()
+			want[top]--
+		}
+		last := -1
+		for _, e := range h.Drain() {
+			if e.(int) < last {
+				t.Fatalf("arity %d: drain out of order", d)
+			}
+			last = e.(int)
+		}
+	}
+}
+
+func benchmarkPushPop(b *testing.B, d int) {
+	const n = 1 << 16
+	r := rand.New(rand.NewSource(1))
+	h := NewWithOptions(intCmp, WithArity(d))
+	for i := 0; i < n; i++ {
+		h.Push(r.Int())
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h.Push(r.Int())
+		h.Pop()
+	}
+}
+
+func BenchmarkPushPop2(b *testing.B) { benchmarkPushPop(b, 2) }
+func BenchmarkPushPop4(b *testing.B) { benchmarkPushPop(b, 4) }
+func BenchmarkPushPop8(b *testing.B) { benchmarkPushPop(b, 8) }
@@ -0,0 +1,800 @@
+package list
+
+//@Title		list
+//@Description
+//		list双向链表容器包
+//		以带哨兵节点的环形双向链表的形式实现
+//		插入元素时返回该元素的节点,可通过节点在O(1)时间内于其前后插入、删除或移动元素
+//		支持链表的拼接、逆转、归并排序、去重以及有序链表的合并
+//		可接纳不同类型的元素,但为了便于比较,建议使用同一个类型
+import (
+	"github.com/hlccd/goSTL/utils/comparator"
+	"github.com/hlccd/goSTL/utils/iterator"
+	"sync"
+	"unsafe"
+)
+
+//list双向链表结构体
+//root为哨兵节点,其next为首节点,pre为尾节点,链表为空时均指向其自身
+//比较器仅用于排序、去重和合并,在创建时传入,若不传入则在使用时以首个元素寻找默认比较器
+type list struct {
+	root  Element               //哨兵节点
+	num   int                   //存储元素数量
+	cmp   comparator.Comparator //比较器
+	mutex sync.Mutex            //并发控制锁
+}
+
+//Element节点结构体
+//插入元素时返回,作为该元素的句柄使用
+//list记录该节点所在的链表,节点被删除后置为nil,该节点随之失效
+type Element struct {
+	value interface{} //节点中存储的元素
+	pre   *Element    //前一个节点
+	next  *Element    //后一个节点
+	list  *list       //节点所在的链表
+}
+
+//list双向链表容器接口
+//存放了list容器可使用的函数
+//对应函数介绍见下方
+type lister interface {
+	Iterator() (i *iterator.Iterator)                        //返回一个包含list中所有元素的迭代器
+	Size() (num int)                                         //返回该链表中元素的数量
+	Clear()                                                  //清空该链表
+	Empty() (b bool)                                         //判断该链表是否为空
+	Begin() (el *Element)                                    //返回该链表的首节点
+	End() (el *Element)                                      //返回该链表的尾节点
+	Front() (e interface{})                                  //返回该链表的首元素
+	Back() (e interface{})                                   //返回该链表的尾元素
+	PushFront(e interface{}) (el *Element)                   //将元素e添加到链表首部并返回其节点
+	PushBack(e interface{}) (el *Element)                    //将元素e添加到链表尾部并返回其节点
+	PopFront() (e interface{})                               //弹出链表首元素并返回
+	PopBack() (e interface{})                                //弹出链表尾元素并返回
+	InsertBefore(e interface{}, mark *Element) (el *Element) //在节点mark之前插入元素e并返回其节点
+	InsertAfter(e interface{}, mark *Element) (el *Element)  //在节点mark之后插入元素e并返回其节点
+	Remove(el *Element) (e interface{})                      //删除节点el并返回其元素
+	Contains(el *Element) (b bool)                           //判断节点el是否在该链表中
+	MoveToFront(el *Element)                                 //将节点el移至链表首部
+	MoveToBack(el *Element)                                  //将节点el移至链表尾部
+	Splice(mark *Element, other *list)                       //将另一个链表的全部节点移至节点mark之前
+	Reverse()                                                //逆转该链表
+	Sort()                                                   //对该链表进行稳定排序
+	Unique()                                                 //删除相邻的重复元素
+	Merge(other *list)                                       //将另一个有序链表合并入该有序链表
+}
+
+//@title    New
+//@description
+//		新建一个list双向链表容器并返回
+//		初始哨兵节点的前后节点均指向其自身
+//		若有传入的比较器,则将传入的第一个比较器设为该链表的比较器
+//@receiver		nil
+//@param    	Cmp			 ...comparator.Comparator	list比较器集
+//@return    	l        	*list						新建的list指针
+func New(Cmp ...comparator.Comparator) (l *list) {
+	var cmp comparator.Comparator
+	if len(Cmp) > 0 {
+		cmp = Cmp[0]
+	}
+	l = &list{
+		num:   0,
+		cmp:   cmp,
+		mutex: sync.Mutex{},
+	}
+	l.root.pre, l.root.next = &l.root, &l.root
+	return l
+}
+
+//@title    Value
+//@description
+//		以Element节点做接收者
+//		返回节点中存储的元素
+//@receiver		el			*Element				接受者Element的指针
+//@param    	nil
+//@return    	e			interface{}				节点中存储的元素
+func (el *Element) Value() (e interface{}) {
+	if el == nil {
+		return nil
+	}
+	return el.value
+}
+
+//@title    Next
+//@description
+//		以Element节点做接收者
+//		返回该节点的后一个节点
+//		若该节点为尾节点或已失效则返回nil
+//@receiver		el			*Element				接受者Element的指针
+//@param    	nil
+//@return    	n			*Element				后一个节点
+func (el *Element) Next() (n *Element) {
+	if el == nil || el.list == nil || el.next == &el.list.root {
+		return nil
+	}
+	return el.next
+}
+
+//@title    Pre
+//@description
+//		以Element节点做接收者
+//		返回该节点的前一个节点
+//		若该节点为首节点或已失效则返回nil
+//@receiver		el			*Element				接受者Element的指针
+//@param    	nil
+//@return    	p			*Element				前一个节点
+func (el *Element) Pre() (p *Element) {
+	if el == nil || el.list == nil || el.pre == &el.list.root {
+		return nil
+	}
+	return el.pre
+}
+
+//@title    Iterator
+//@description
+//		以list双向链表容器做接收者
+//		将链表中的元素由首至尾放入切片中
+//		返回一个包含容器中所有元素的迭代器
+//@receiver		l			*list					接受者list的指针
+//@param    	nil
+//@return    	i        	*iterator.Iterator		新建的Iterator迭代器指针
+func (l *list) Iterator() (i *iterator.Iterator) {
+	if l == nil {
+		return iterator.New(make([]interface{}, 0, 0))
+	}
+	l.mutex.Lock()
+	es := make([]interface{}, 0, l.num)
+	for p := l.root.next; p != &l.root; p = p.next {
+		es = append(es, p.value)
+	}
+	i = iterator.New(es)
+	l.mutex.Unlock()
+	return i
+}
+
+//@title    Size
+//@description
+//		以list双向链表容器做接收者
+//		返回该容器当前含有元素的数量
+//		当容器为nil时返回-1
+//@receiver		l			*list					接受者list的指针
+//@param    	nil
+//@return    	num        	int						容器中存储元素的个数
+func (l *list) Size() (num int) {
+	if l == nil {
+		return -1
+	}
+	return l.num
+}
+
+//@title    Clear
+//@description
+//		以list双向链表容器做接收者
+//		将该容器中所承载的元素清空
+//		原有节点全部失效
+//@receiver		l			*list					接受者list的指针
+//@param    	nil
+//@return    	nil
+func (l *list) Clear() {
+	if l == nil {
+		return
+	}
+	l.mutex.Lock()
+	for p := l.root.next; p != &l.root; {
+		n := p.next
+		p.pre, p.next, p.list = nil, nil, nil
+		p = n
+	}
+	l.root.pre, l.root.next = &l.root, &l.root
+	l.num = 0
+	l.mutex.Unlock()
+}
+
+//@title    Empty
+//@description
+//		以list双向链表容器做接收者
+//		判断该list双向链表容器是否含有元素
+//		如果含有元素则不为空,返回false
+//		如果不含有元素则说明为空,返回true
+//		如果容器不存在,返回true
+//@receiver		l			*list					接受者list的指针
+//@param    	nil
+//@return    	b			bool					该容器是空的吗?
+func (l *list) Empty() (b bool) {
+	if l == nil {
+		return true
+	}
+	return l.Size() <= 0
+}
+
+//@title    Begin
+//@description
+//		以list双向链表容器做接收者
+//		返回该链表的首节点,可结合节点的Next方法遍历链表
+//		若该容器当前为空,则返回nil
+//@receiver		l			*list					接受者list的指针
+//@param    	nil
+//@return    	el			*Element				链表的首节点
+func (l *list) Begin() (el *Element) {
+	if l == nil {
+		return nil
+	}
+	l.mutex.Lock()
+	if l.num > 0 {
+		el = l.root.next
+	}
+	l.mutex.Unlock()
+	return el
+}
+
+//@title    End
+//@description
+//		以list双向链表容器做接收者
+//		返回该链表的尾节点,可结合节点的Pre方法逆序遍历链表
+//		若该容器当前为空,则返回nil
+//@receiver		l			*list					接受者list的指针
+//@param    	nil
+//@return    	el			*Element				链表的尾节点
+func (l *list) End() (el *Element) {
+	if l == nil {
+		return nil
+	}
+	l.mutex.Lock()
+	if l.num > 0 {
+		el = l.root.pre
+	}
+	l.mutex.Unlock()
+	return el
+}
+
+//@title    Front
+//@description
+//		以list双向链表容器做接收者
+//		返回该容器的第一个元素
+//		若该容器当前为空,则返回nil
+//@receiver		l			*list					接受者list的指针
+//@param    	nil
+//@return    	e			interface{}				容器的第一个元素
+func (l *list) Front() (e interface{}) {
+	return l.Begin().Value()
+}
+
+//@title    Back
+//@description
+//		以list双向链表容器做接收者
+//		返回该容器的最后一个元素
+//		若该容器当前为空,则返回nil
+//@receiver		l			*list					接受者list的指针
+//@param    	nil
+//@return    	e			interface{}				容器的最后一个元素
+func (l *list) Back() (e interface{}) {
+	return l.End().Value()
+}
+
+//@title    insert
+//@description
+//		以list双向链表容器做接收者
+//		将节点el插入到节点at之后
+//@receiver		l			*list					接受者list的指针
+//@param    	el			*Element				待插入的节点
+//@param    	at			*Element				插入位置的前一个节点
+//@return    	nil
+func (l *list) insert(el, at *Element) {
+	el.pre, el.next = at, at.next
+	at.next.pre = el
+	at.next = el
+	el.list = l
+	l.num++
+}
+
+//@title    remove
+//@description
+//		以list双向链表容器做接收者
+//		将节点el从链表中删除并使其失效
+//@receiver		l			*list					接受者list的指针
+//@param    	el			*Element				待删除的节点
+//@return    	nil
+func (l *list) remove(el *Element) {
+	el.pre.next = el.next
+	el.next.pre = el.pre
+	el.pre, el.next, el.list = nil, nil, nil
+	l.num--
+}
+
+//@title    move
+//@description
+//		以list双向链表容器做接收者
+//		将链表中的节点el移至节点at之后
+//@receiver		l			*list					接受者list的指针
+//@param    	el			*Element				待移动的节点
+//@param    	at			*Element				目标位置的前一个节点
+//@return    	nil
+func (l *list) move(el, at *Element) {
+	if el == at || el.pre == at {
+		return
+	}
+	el.pre.next = el.next
+	el.next.pre = el.pre
+	el.pre, el.next = at, at.next
+	at.next.pre = el
+	at.next = el
+}
+
+//@title    PushFront
+//@description
+//		以list双向链表容器做接收者
+//		在链表首部插入元素e并返回其节点
+//@receiver		l			*list					接受者list的指针
+//@param    	e			interface{}				待插入元素
+//@return    	el			*Element				该元素的节点
+func (l *list) PushFront(e interface{}) (el *Element) {
+	if l == nil {
+		return nil
+	}
+	l.mutex.Lock()
+	el = &Element{value: e}
+	l.insert(el, &l.root)
+	l.mutex.Unlock()
+	return el
+}
+
+//@title    PushBack
+//@description
+//		以list双向链表容器做接收者
+//		在链表尾部插入元素e并返回其节点
+//@receiver		l			*list					接受者list的指针
+//@param    	e			interface{}				待插入元素
+//@return    	el			*Element				该元素的节点
+func (l *list) PushBack(e interface{}) (el *Element) {
+	if l == nil {
+		return nil
+	}
+	l.mutex.Lock()
+	el = &Element{value: e}
+	l.insert(el, l.root.pre)
+	l.mutex.Unlock()
+	return el
+}
+
+//@title    PopFront
+//@description
+//		以list双向链表容器做接收者
+//		删除链表的首节点并返回其元素
+//		若该容器当前为空,则返回nil
+//@receiver		l			*list					接受者list的指针
+//@param    	nil
+//@return    	e			interface{}				链表的首元素
+func (l *list) PopFront() (e interface{}) {
+	if l == nil {
+		return nil
+	}
+	l.mutex.Lock()
+	if l.num > 0 {
+		e = l.root.next.value
+		l.remove(l.root.next)
+	}
+	l.mutex.Unlock()
+	return e
+}
+
+//@title    PopBack
+//@description
+//		以list双向链表容器做接收者
+//		删除链表的尾节点并返回其元素
+//		若该容器当前为空,则返回nil
+//@receiver		l			*list					接受者list的指针
+//@param    	nil
+//@return    	e			interface{}				链表的尾元素
+func (l *list) PopBack() (e interface{}) {
+	if l == nil {
+		return nil
+	}
+	l.mutex.Lock()
+	if l.num > 0 {
+		e = l.root.pre.value
+		l.remove(l.root.pre)
+	}
+	l.mutex.Unlock()
+	return e
+}
+
+//@title    InsertBefore
+//@description
+//		以list双向链表容器做接收者
+//		在节点mark之前插入元素e并返回其节点
+//		若mark不在该链表中则不插入并返回nil
+//@receiver		l			*list					接受者list的指针
+//@param    	e			interface{}				待插入元素
+//@param    	mark		*Element				插入位置的后一个节点
+//@return    	el			*Element				该元素的节点
+func (l *list) InsertBefore(e interface{}, mark *Element) (el *Element) {
+	if l == nil || mark == nil {
+		return nil
+	}
+	l.mutex.Lock()
+	if mark.list != l {
+		l.mutex.Unlock()
+		return nil
+	}
+	el = &Element{value: e}
+	l.insert(el, mark.pre)
+	l.mutex.Unlock()
+	return el
+}
+
+//@title    InsertAfter
+//@description
+//		以list双向链表容器做接收者
+//		在节点mark之后插入元素e并返回其节点
+//		若mark不在该链表中则不插入并返回nil
+//@receiver		l			*list					接受者list的指针
+//@param    	e			interface{}				待插入元素
+//@param    	mark		*Element				插入位置的前一个节点
+//@return    	el			*Element				该元素的节点
+func (l *list) InsertAfter(e interface{}, mark *Element) (el *Element) {
+	if l == nil || mark == nil {
+		return nil
+	}
+	l.mutex.Lock()
+	if mark.list != l {
+		l.mutex.Unlock()
+		return nil
+	}
+	el = &Element{value: e}
+	l.insert(el, mark)
+	l.mutex.Unlock()
+	return el
+}
+
+//@title    Remove
+//@description
+//		以list双向链表容器做接收者
+//		删除节点el并返回其元素,删除后该节点失效
+//		若el不在该链表中则不删除并返回nil
+//@receiver		l			*list					接受者list的指针
+//@param    	el			*Element				待删除的节点
+//@return    	e			interface{}				被删除的元素
+func (l *list) Remove(el *Element) (e interface{}) {
+	if l == nil || el == nil {
+		return nil
+	}
+	l.mutex.Lock()
+	if el.list != l {
+		l.mutex.Unlock()
+		return nil
+	}
+	e = el.value
+	l.remove(el)
+	l.mutex.Unlock()
+	return e
+}
+
+//@title    Contains
+//@description
+//		以list双向链表容器做接收者
+//		判断节点el是否在该链表中
+//@receiver		l			*list					接受者list的指针
+//@param    	el			*Element				待判断的节点
+//@return    	b			bool					该节点在链表中吗?
+func (l *list) Contains(el *Element) (b bool) {
+	if l == nil || el == nil {
+		return false
+	}
+	l.mutex.Lock()
+	b = el.list == l
+	l.mutex.Unlock()
+	return b
+}
+
+//@title    MoveToFront
+//@description
+//		以list双向链表容器做接收者
+//		将节点el移至链表首部,该节点依然有效
+//		若el不在该链表中则不做移动
+//@receiver		l			*list					接受者list的指针
+//@param    	el			*Element				待移动的节点
+//@return    	nil
+func (l *list) MoveToFront(el *Element) {
+	if l == nil || el == nil {
+		return
+	}
+	l.mutex.Lock()
+	if el.list == l {
+		l.move(el, &l.root)
+	}
+	l.mutex.Unlock()
+}
+
+//@title    MoveToBack
+//@description
+//		以list双向链表容器做接收者
+//		将节点el移至链表尾部,该节点依然有效
+//		若el不在该链表中则不做移动
+//@receiver		l			*list					接受者list的指针
+//@param    	el			*Element				待移动的节点
+//@return    	nil
+func (l *list) MoveToBack(el *Element) {
+	if l == nil || el == nil {
+		return
+	}
+	l.mutex.Lock()
+	if el.list == l {
+		l.move(el, l.root.pre)
+	}
+	l.mutex.Unlock()
+}
+
+//@title    detach
+//@description
+//		以list双向链表容器做接收者
+//		将该链表的全部节点取出,返回首尾节点及节点数量,取出后该链表为空
+//		取出的节点仍记录原链表,需由调用者在持有两个链表的锁时重新设置
+//@receiver		l			*list					接受者list的指针
+//@param    	nil
+//@return    	first		*Element				首节点
+//@return    	last		*Element				尾节点
+//@return    	num			int						节点数量
+func (l *list) detach() (first, last *Element, num int) {
+	if l.num == 0 {
+		return nil, nil, 0
+	}
+	first, last, num = l.root.next, l.root.pre, l.num
+	l.root.pre, l.root.next = &l.root, &l.root
+	l.num = 0
+	return first, last, num
+}
+
+//@title    lockPair
+//@description
+//		按地址顺序对链表a和b加锁
+//		两个链表相互Splice或Merge时加锁顺序一致,不会死锁
+//@receiver		nil
+//@param    	a			*list					待加锁的链表
+//@param    	b			*list					待加锁的链表
+//@return    	nil
+func lockPair(a, b *list) {
+	if uintptr(unsafe.Pointer(a)) > uintptr(unsafe.Pointer(b)) {
+		a, b = b, a
+	}
+	a.mutex.Lock()
+	b.mutex.Lock()
+}
+
+//@title    Splice
+//@description
+//		以list双向链表容器做接收者
+//		将另一个链表的全部节点按原顺序移至节点mark之前,移入后另一个链表为空
+//		mark为nil或不在该链表中时移至该链表尾部
+//		节点之间的链接只需O(1)次修改,另一个链表中的节点在该链表中依然有效
+//		若两者为同一个链表则不做移动
+//@receiver		l			*list					接受者list的指针
+//@param    	mark		*Element				移入位置的后一个节点
+//@param    	other		*list					待移入的链表
+//@return    	nil
+func (l *list) Splice(mark *Element, other *list) {
+	if l == nil || other == nil || l == other {
+		return
+	}
+	//同时持有两个链表的锁,使节点在移动过程中不会被另一个链表访问
+	lockPair(l, other)
+	first, last, num := other.detach()
+	if num == 0 {
+		other.mutex.Unlock()
+		l.mutex.Unlock()
+		return
+	}
+	at := l.root.pre
+	if mark != nil && mark.list == l {
+		at = mark.pre
+	}
+	for p := first; p != last.next; p = p.next {
+		p.list = l
+	}
+	first.pre, last.next = at, at.next
+	at.next.pre = last
+	at.next = first
+	l.num += num
+	other.mutex.Unlock()
+	l.mutex.Unlock()
+}
+
+//@title    Reverse
+//@description
+//		以list双向链表容器做接收者
+//		交换每个节点的前后指针以逆转链表,节点依然有效
+//@receiver		l			*list					接受者list的指针
+//@param    	nil
+//@return    	nil
+func (l *list) Reverse() {
+	if l == nil {
+		return
+	}
+	l.mutex.Lock()
+	p := &l.root
+	for {
+		p.pre, p.next = p.next, p.pre
+		p = p.pre
+		if p == &l.root {
+			break
+		}
+	}
+	l.mutex.Unlock()
+}
+
+//@title    getCmp
+//@description
+//		以list双向链表容器做接收者
+//		若该链表尚无比较器,则以首元素寻找默认比较器
+//		返回是否存在可用的比较器
+//@receiver		l			*list					接受者list的指针
+//@param    	nil
+//@return    	b			bool					比较器是否可用
+func (l *list) getCmp() (b bool) {
+	if l.cmp == nil && l.num > 0 {
+		l.cmp = comparator.GetCmp(l.root.next.value)
+	}
+	return l.cmp != nil
+}
+
+//@title    relink
+//@description
+//		以list双向链表容器做接收者
+//		以仅由next串联且以nil结尾的节点链重建该链表的前驱指针及哨兵节点
+//@receiver		l			*list					接受者list的指针
+//@param    	head		*Element				节点链的首节点
+//@return    	nil
+func (l *list) relink(head *Element) {
+	pre := &l.root
+	for p := head; p != nil; p = p.next {
+		p.pre = pre
+		pre.next = p
+		pre = p
+	}
+	pre.next = &l.root
+	l.root.pre = pre
+}
+
+//@title    merge
+//@description
+//		将两条由next串联且以nil结尾的有序节点链合并为一条有序节点链并返回其首节点
+//		元素相等时a中的节点在前,以保证稳定性
+//@receiver		nil
+//@param    	a			*Element				第一条节点链
+//@param    	b			*Element				第二条节点链
+//@param    	cmp			comparator.Comparator	比较器
+//@return    	head		*Element				合并后的首节点
+func merge(a, b *Element, cmp comparator.Comparator) (head *Element) {
+	var h Element
+	t := &h
+	for a != nil && b != nil {
+		if cmp(a.value, b.value) <= 0 {
+			t.next, a = a, a.next
+		} else {
+			t.next, b = b, b.next
+		}
+		t = t.next
+	}
+	if a != nil {
+		t.next = a
+	} else {
+		t.next = b
+	}
+	return h.next
+}
+
+//@title    mergeSort
+//@description
+//		对以head为首的n个节点进行归并排序,返回排序后的首节点
+//		排序后的节点链由next串联且以nil结尾,第n个节点之后的节点由rest返回
+//@receiver		nil
+//@param    	head		*Element				待排序的首节点
+//@param    	n			int						待排序的节点数量
+//@param    	cmp			comparator.Comparator	比较器
+//@return    	sorted		*Element				排序后的首节点
+//@return    	rest		*Element				未参与排序的首个节点
+func mergeSort(head *Element, n int, cmp comparator.Comparator) (sorted, rest *Element) {
+	if n == 1 {
+		rest = head.next
+		head.next = nil
+		return head, rest
+	}
+	a, rest := mergeSort(head, n/2, cmp)
+	b, rest := mergeSort(rest, n-n/2, cmp)
+	return merge(a, b, cmp), rest
+}
+
+//@title    Sort
+//@description
+//		以list双向链表容器做接收者
+//		利用比较器对链表节点进行归并排序,排序是稳定的
+//		只修改节点间的链接而不移动元素,节点依然有效,时间复杂度为O(n log n)
+//		若无法确定比较器则不排序
+//@receiver		l			*list					接受者list的指针
+//@param    	nil
+//@return    	nil
+func (l *list) Sort() {
+	if l == nil {
+		return
+	}
+	l.mutex.Lock()
+	if l.num > 1 && l.getCmp() {
+		head, _ := mergeSort(l.root.next, l.num, l.cmp)
+		l.relink(head)
+	}
+	l.mutex.Unlock()
+}
+
+//@title    Unique
+//@description
+//		以list双向链表容器做接收者
+//		删除相邻的重复元素,每组相邻的相等元素只保留第一个
+//		对有序链表使用即可删除全部重复元素,被删除的节点失效
+//		若无法确定比较器则不做删除
+//@receiver		l			*list					接受者list的指针
+//@param    	nil
+//@return    	nil
+func (l *list) Unique() {
+	if l == nil {
+		return
+	}
+	l.mutex.Lock()
+	if l.num > 1 && l.getCmp() {
+		for p := l.root.next; p.next != &l.root; {
+			if l.cmp(p.value, p.next.value) == 0 {
+				l.remove(p.next)
+			} else {
+				p = p.next
+			}
+		}
+	}
+	l.mutex.Unlock()
+}
+
+//@title    Merge
+//@description
+//		以list双向链表容器做接收者
+//		将另一个有序链表的全部节点合并入该有序链表,合并后该链表依然有序,另一个链表为空
+//		元素相等时该链表中的节点在前,时间复杂度为O(n+m)
+//		另一个链表中的节点在该链表中依然有效
+//		若两者为同一个链表则不做合并,若无法确定比较器则直接将另一个链表接在该链表尾部
+//@receiver		l			*list					接受者list的指针
+//@param    	other		*list					待合并的有序链表
+//@return    	nil
+func (l *list) Merge(other *list) {
+	if l == nil || other == nil || l == other {
+		return
+	}
+	//同时持有两个链表的锁,使节点在移动过程中不会被另一个链表访问
+	lockPair(l, other)
+	first, last, num := other.detach()
+	if num == 0 {
+		other.mutex.Unlock()
+		l.mutex.Unlock()
+		return
+	}
+	if l.cmp == nil {
+		l.cmp = other.cmp
+	}
+	if l.cmp == nil {
+		l.cmp = comparator.GetCmp(first.value)
+	}
+	last.next = nil
+	for p := first; p != nil; p = p.next {
+		p.list = l
+	}
+	if l.cmp == nil {
+		//无法比较时直接接在尾部,保证另一个链表中的元素不丢失
+		l.root.pre.next = first
+		l.relink(l.root.next)
+	} else {
+		a := l.root.next
+		if l.num == 0 {
+			a = nil
+		} else {
+			l.root.pre.next = nil
+		}
+		l.relink(merge(a, first, l.cmp))
+	}
+	l.num += num
+	other.mutex.Unlock()
+	l.mutex.Unlock()
+}
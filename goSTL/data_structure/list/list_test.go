@@ -0,0 +1,274 @@
+package list
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+	"testing"
+)
+
+//record只以key比较,tag用于区分相等的元素以检查稳定性
+type record struct {
+	key, tag int
+}
+
+func recordCmp(a, b interface{}) int {
+	x, y := a.(record), b.(record)
+	if x.key < y.key {
+		return -1
+	}
+	if x.key > y.key {
+		return 1
+	}
+	return 0
+}
+
+//checkModel检查链表正反两个方向的节点顺序均与参照切片一致
+func checkModel(t *testing.T, l *list, model []*Element) {
+	t.Helper()
+	if l.Size() != len(model) {
+		t.Fatalf("Size = %d, want %d", l.Size(), len(model))
+	}
+	i := 0
+	for el := l.Begin(); el != nil; el = el.Next() {
+		if i >= len(model) || el != model[i] {
+			t.Fatalf("forward walk differs at %d", i)
+		}
+		i++
+	}
+	if i != len(model) {
+		t.Fatalf("forward walk visited %d nodes, want %d", i, len(model))
+	}
+	for el := l.End(); el != nil; el = el.Pre() {
+		i--
+		if i < 0 || el != model[i] {
+			t.Fatalf("backward walk differs at %d", i)
+		}
+	}
+	i = 0
+	for it := l.Iterator(); it.HasNext(); it.Next() {
+		if it.Value() != model[i].value {
+			t.Fatalf("Iterator[%d] = %v, want %v", i, it.Value(), model[i].value)
+		}
+		i++
+	}
+}
+
+//indexOf返回节点在参照切片中的位置,不存在时返回-1
+func indexOf(model []*Element, el *Element) int {
+	for i, e := range model {
+		if e == el {
+			return i
+		}
+	}
+	return -1
+}
+
+func insertAt(model []*Element, i int, el *Element) []*Element {
+	return append(model[:i], append([]*Element{el}, model[i:]...)...)
+}
+
+func removeAt(model []*Element, i int) []*Element {
+	return append(model[:i:i], model[i+1:]...)
+}
+
+//sortModel以节点元素稳定排序
+func sortModel(model []*Element) {
+	sort.SliceStable(model, func(i, j int) bool {
+		return recordCmp(model[i].value, model[j].value) < 0
+	})
+}
+
+func TestListModel(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	ls := []*list{New(recordCmp), New(recordCmp)}
+	models := make([][]*Element, 2)
+	all := make([]*Element, 0, 0)
+	pick := func() *Element {
+		if len(all) == 0 {
+			return nil
+		}
+		return all[r.Intn(len(all))]
+	}
+	for it := 0; it < 20000; it++ {
+		i := r.Intn(2)
+		l, o := ls[i], ls[1-i]
+		v := record{key: r.Intn(10), tag: it}
+		switch r.Intn(14) {
+		case 0:
+			el := l.PushFront(v)
+			models[i] = insertAt(models[i], 0, el)
+			all = append(all, el)
+		case 1:
+			el := l.PushBack(v)
+			models[i] = append(models[i], el)
+			all = append(all, el)
+		case 2:
+			e := l.PopFront()
+			if len(models[i]) == 0 {
+				if e != nil {
+					t.Fatalf("PopFront on empty list = %v", e)
+				}
+				continue
+			}
+			if e != models[i][0].value {
+				t.Fatalf("PopFront = %v, want %v", e, models[i][0].value)
+			}
+			models[i] = models[i][1:]
+		case 3:
+			e := l.PopBack()
+			if n := len(models[i]); n == 0 && e != nil || n > 0 && e != models[i][n-1].value {
+				t.Fatalf("PopBack = %v", e)
+			}
+			if n := len(models[i]); n > 0 {
+				models[i] = models[i][:n-1]
+			}
+		case 4, 5:
+			//mark可能属于另一个链表或已失效
+			mark := pick()
+			p := indexOf(models[i], mark)
+			var el *Element
+			if r.Intn(2) == 0 {
+				el = l.InsertBefore(v, mark)
+			} else {
+				el = l.InsertAfter(v, mark)
+				p++
+			}
+			if (indexOf(models[i], mark) < 0) != (el == nil) {
+				t.Fatalf("InsertBefore/After with a foreign mark returned %v", el)
+			}
+			if el != nil {
+				models[i] = insertAt(models[i], p, el)
+				all = append(all, el)
+			}
+		case 6:
+			el := pick()
+			p := indexOf(models[i], el)
+			e := l.Remove(el)
+			if p < 0 {
+				if e != nil {
+					t.Fatalf("Remove of a foreign node = %v", e)
+				}
+				continue
+			}
+			if e != el.value {
+				t.Fatalf("Remove = %v, want %v", e, el.value)
+			}
+			models[i] = removeAt(models[i], p)
+		case 7:
+			el := pick()
+			if p := indexOf(models[i], el); p >= 0 {
+				models[i] = removeAt(models[i], p)
+				if r.Intn(2) == 0 {
+					l.MoveToFront(el)
+					models[i] = insertAt(models[i], 0, el)
+				} else {
+					l.MoveToBack(el)
+					models[i] = append(models[i], el)
+				}
+			} else {
+				l.MoveToFront(el)
+			}
+		case 8:
+			//mark不在该链表中时接在尾部
+			mark := pick()
+			p := indexOf(models[i], mark)
+			if p < 0 {
+				p = len(models[i])
+			}
+			l.Splice(mark, o)
+			rest := append(models[1-i], models[i][p:]...)
+			models[i] = append(models[i][:p:p], rest...)
+			models[1-i] = nil
+		case 9:
+			l.Reverse()
+			for a, b := 0, len(models[i])-1; a < b; a, b = a+1, b-1 {
+				models[i][a], models[i][b] = models[i][b], models[i][a]
+			}
+		case 10:
+			l.Sort()
+			sortModel(models[i])
+		case 11:
+			l.Unique()
+			kept := make([]*Element, 0, len(models[i]))
+			for _, el := range models[i] {
+				if len(kept) == 0 || recordCmp(kept[len(kept)-1].value, el.value) != 0 {
+					kept = append(kept, el)
+				}
+			}
+			models[i] = kept
+		case 12:
+			//两个链表均有序时合并,相等元素中该链表的节点在前
+			l.Sort()
+			o.Sort()
+			l.Merge(o)
+			models[i] = append(models[i], models[1-i]...)
+			sortModel(models[i])
+			models[1-i] = nil
+		case 13:
+			if r.Intn(50) == 0 {
+				l.Clear()
+				models[i] = nil
+			}
+		}
+		checkModel(t, l, models[i])
+		checkModel(t, o, models[1-i])
+		if el := pick(); el != nil && (l.Contains(el) != (indexOf(models[i], el) >= 0)) {
+			t.Fatalf("Contains = %v", l.Contains(el))
+		}
+	}
+}
+
+func TestSortStable(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for _, n := range []int{0, 1, 2, 3, 7, 100, 1000} {
+		l := New(recordCmp)
+		model := make([]record, 0, n)
+		for i := 0; i < n; i++ {
+			v := record{key: r.Intn(5), tag: i}
+			l.PushBack(v)
+			model = append(model, v)
+		}
+		sort.SliceStable(model, func(i, j int) bool { return model[i].key < model[j].key })
+		l.Sort()
+		i := 0
+		for el := l.Begin(); el != nil; el = el.Next() {
+			if el.Value() != model[i] {
+				t.Fatalf("n=%d: Sort[%d] = %v, want %v", n, i, el.Value(), model[i])
+			}
+			i++
+		}
+	}
+}
+
+func TestConcurrentSplice(t *testing.T) {
+	a, b := New(recordCmp), New(recordCmp)
+	els := make([][]*Element, 4)
+	var wg sync.WaitGroup
+	for g := range els {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			//两个方向同时拼接,加锁顺序一致,不应死锁
+			src, dst := a, b
+			if g%2 == 1 {
+				src, dst = b, a
+			}
+			for i := 0; i < 500; i++ {
+				els[g] = append(els[g], src.PushBack(record{key: i}))
+				dst.Splice(nil, src)
+			}
+		}(g)
+	}
+	wg.Wait()
+	if a.Size()+b.Size() != 2000 {
+		t.Fatalf("Size = %d+%d, want 2000", a.Size(), b.Size())
+	}
+	for _, es := range els {
+		for _, el := range es {
+			if a.Contains(el) == b.Contains(el) {
+				t.Fatal("spliced node is not in exactly one list")
+			}
+		}
+	}
+}
@@ -0,0 +1,393 @@
+package minMaxHeap
+
+//@Title		minMaxHeap
+//@Description
+//		最小最大堆-Min-Max Heap
+//		以切片数组的形式实现的双端优先队列,可同时在O(1)时间内获取最小和最大元素
+//		与二叉堆相同以完全二叉树的形式存储,偶数层(根为第0层)的节点不大于其全部子孙,奇数层的节点不小于其全部子孙
+//		故根节点为最小元素,最大元素为根的两个子节点中较大的一个
+//		插入和弹出最小、最大元素的时间复杂度均为O(log n),批量建堆的时间复杂度为O(n)
+//		大小关系由比较器决定,若使用默认比较器则与heap的顶端元素一致
+import (
+	"github.com/hlccd/goSTL/utils/comparator"
+	"github.com/hlccd/goSTL/utils/iterator"
+	"math/bits"
+	"sync"
+)
+
+//minMaxHeap最小最大堆结构体
+//包含泛型切片和比较器
+//增删元素后会使用比较器保持该切片数组满足最小最大堆的性质
+type minMaxHeap struct {
+	data  []interface{}         //泛型切片
+	cmp   comparator.Comparator //该堆的比较器
+	mutex sync.Mutex            //并发控制锁
+}
+
+//minMaxHeap最小最大堆容器接口
+//存放了minMaxHeap容器可使用的函数
+//对应函数介绍见下方
+type minMaxHeaper interface {
+	Iterator() (i *iterator.Iterator) //返回一个包含minMaxHeap容器中所有使用元素的迭代器
+	Size() (num int)                  //返回该容器存储的元素数量
+	Clear()                           //清空该容器
+	Empty() (b bool)                  //判断该容器是否为空
+	PushBack(e interface{})           //将元素e插入该容器
+	PopMin() (e interface{})          //弹出并返回最小元素
+	PopMax() (e interface{})          //弹出并返回最大元素
+	Min() (e interface{})             //返回最小元素
+	Max() (e interface{})             //返回最大元素
+}
+
+//@title    New
+//@description
+//		新建一个minMaxHeap最小最大堆容器并返回
+//		初始minMaxHeap的切片数组为空
+//		如果有传入比较器,则将传入的第一个比较器设为该堆的比较器
+//@receiver		nil
+//@param    	Cmp			...comparator.Comparator	minMaxHeap的比较器集
+//@return    	mm        	*minMaxHeap					新建的minMaxHeap指针
+func New(cmps ...comparator.Comparator) (mm *minMaxHeap) {
+	var cmp comparator.Comparator
+	if len(cmps) == 0 {
+		cmp = nil
+	} else {
+		cmp = cmps[0]
+	}
+	return &minMaxHeap{
+		data:  make([]interface{}, 0, 0),
+		cmp:   cmp,
+		mutex: sync.Mutex{},
+	}
+}
+
+//@title    FromSlice
+//@description
+//		以传入的切片新建一个minMaxHeap最小最大堆容器并返回
+//		复制切片中的元素后自最后一个非叶子节点起依次向前进行下沉,时间复杂度为O(n)
+//		如果有传入比较器,则将传入的第一个比较器设为该堆的比较器,否则以首个元素寻找默认比较器
+//		若无法确定比较器则返回空堆
+//@receiver		nil
+//@param    	data		[]interface{}				初始元素
+//@param    	Cmp			...comparator.Comparator	minMaxHeap的比较器集
+//@return    	mm        	*minMaxHeap					新建的minMaxHeap指针
+func FromSlice(data []interface{}, cmps ...comparator.Comparator) (mm *minMaxHeap) {
+	mm = New(cmps...)
+	if len(data) == 0 {
+		return mm
+	}
+	if mm.cmp == nil {
+		mm.cmp = comparator.GetCmp(data[0])
+	}
+	if mm.cmp == nil {
+		return mm
+	}
+	mm.data = append(mm.data, data...)
+	for p := len(mm.data)/2 - 1; p >= 0; p-- {
+		mm.down(p)
+	}
+	return mm
+}
+
+//@title    Iterator
+//@description
+//		以minMaxHeap容器做接收者
+//		返回一个包含容器中所有使用元素的迭代器
+//@receiver		mm			*minMaxHeap				接受者minMaxHeap的指针
+//@param    	nil
+//@return    	i        	*iterator.Iterator		新建的Iterator迭代器指针
+func (mm *minMaxHeap) Iterator() (i *iterator.Iterator) {
+	if mm == nil {
+		return iterator.New(make([]interface{}, 0, 0))
+	}
+	mm.mutex.Lock()
+	i = iterator.New(append(make([]interface{}, 0, len(mm.data)), mm.data...))
+	mm.mutex.Unlock()
+	return i
+}
+
+//@title    Size
+//@description
+//		以minMaxHeap容器做接收者
+//		返回该容器当前含有元素的数量
+//		当容器不存在时,返回-1
+//@receiver		mm			*minMaxHeap				接受者minMaxHeap的指针
+//@param    	nil
+//@return    	num        	int						容器中存储元素的个数
+func (mm *minMaxHeap) Size() (num int) {
+	if mm == nil {
+		return -1
+	}
+	return len(mm.data)
+}
+
+//@title    Clear
+//@description
+//		以minMaxHeap容器做接收者
+//		将该容器中所承载的元素清空
+//@receiver		mm			*minMaxHeap				接受者minMaxHeap的指针
+//@param    	nil
+//@return    	nil
+func (mm *minMaxHeap) Clear() {
+	if mm == nil {
+		return
+	}
+	mm.mutex.Lock()
+	mm.data = mm.data[0:0]
+	mm.mutex.Unlock()
+}
+
+//@title    Empty
+//@description
+//		以minMaxHeap容器做接收者
+//		判断该minMaxHeap容器是否含有元素
+//		如果含有元素则不为空,返回false
+//		如果不含有元素则说明为空,返回true
+//		如果容器不存在,返回true
+//@receiver		mm			*minMaxHeap				接受者minMaxHeap的指针
+//@param    	nil
+//@return    	b			bool					该容器是空的吗?
+func (mm *minMaxHeap) Empty() (b bool) {
+	if mm == nil {
+		return true
+	}
+	return mm.Size() <= 0
+}
+
+//@title    isMinLevel
+//@description
+//		判断下标为i的节点是否处于最小层,即偶数层
+//@receiver		nil
+//@param    	i			int						节点的下标
+//@return    	b			bool					是否处于最小层?
+func isMinLevel(i int) (b bool) {
+	return bits.Len(uint(i+1))%2 == 1
+}
+
+//@title    less
+//@description
+//		以minMaxHeap容器做接收者
+//		在最小层中判断i是否小于j,在最大层中判断i是否大于j
+//		以此统一最小层和最大层的上升与下沉
+//@receiver		mm			*minMaxHeap				接受者minMaxHeap的指针
+//@param    	i			int						位置i
+//@param    	j			int						位置j
+//@param    	min			bool					是否为最小层
+//@return    	b			bool					i是否更靠近所在层的堆顶
+func (mm *minMaxHeap) less(i, j int, min bool) (b bool) {
+	if min {
+		return mm.cmp(mm.data[i], mm.data[j]) < 0
+	}
+	return mm.cmp(mm.data[i], mm.data[j]) > 0
+}
+
+//@title    PushBack
+//@description
+//		以minMaxHeap容器做接收者
+//		在末尾插入元素e后进行上升
+//		先与父节点比较确定其应处于最小层还是最大层,再与同类层的祖父节点逐层比较
+//@receiver		mm			*minMaxHeap				接受者minMaxHeap的指针
+//@param    	e			interface{}				待插入元素
+//@return    	nil
+func (mm *minMaxHeap) PushBack(e interface{}) {
+	if mm == nil {
+		return
+	}
+	mm.mutex.Lock()
+	if mm.cmp == nil {
+		mm.cmp = comparator.GetCmp(e)
+	}
+	if mm.cmp == nil {
+		mm.mutex.Unlock()
+		return
+	}
+	mm.data = append(mm.data, e)
+	mm.up(len(mm.data) - 1)
+	mm.mutex.Unlock()
+}
+
+//@title    up
+//@description
+//		以minMaxHeap容器做接收者
+//		对位置i的元素进行上升
+//		若其与父节点的关系违反父节点所在层的性质,则与父节点交换后在父节点所在的层中上升
+//		否则在其自身所在的层中上升,每次与祖父节点比较
+//@receiver		mm			*minMaxHeap				接受者minMaxHeap的指针
+//@param    	i			int						待上升元素的位置
+//@return    	nil
+func (mm *minMaxHeap) up(i int) {
+	if i == 0 {
+		return
+	}
+	min := isMinLevel(i)
+	p := (i - 1) / 2
+	if mm.less(i, p, !min) {
+		mm.data[i], mm.data[p] = mm.data[p], mm.data[i]
+		i, min = p, !min
+	}
+	for i > 2 {
+		g := ((i-1)/2 - 1) / 2
+		if !mm.less(i, g, min) {
+			break
+		}
+		mm.data[i], mm.data[g] = mm.data[g], mm.data[i]
+		i = g
+	}
+}
+
+//@title    down
+//@description
+//		以minMaxHeap容器做接收者
+//		对位置i的元素进行下沉
+//		在其子节点和孙节点中找出最靠近所在层堆顶的节点m
+//		若m为孙节点且优于该元素则交换,交换后若其与m的父节点违反性质则再与父节点交换,并从m继续下沉
+//		若m为子节点且优于该元素则交换后结束
+//@receiver		mm			*minMaxHeap				接受者minMaxHeap的指针
+//@param    	i			int						待下沉元素的位置
+//@return    	nil
+func (mm *minMaxHeap) down(i int) {
+	min := isMinLevel(i)
+	n := len(mm.data)
+	for 2*i+1 < n {
+		//寻找子节点和孙节点中的最优者
+		m := 2*i + 1
+		for _, c := range [...]int{2*i + 2, 4*i + 3, 4*i + 4, 4*i + 5, 4*i + 6} {
+			if c < n && mm.less(c, m, min) {
+				m = c
+			}
+		}
+		if !mm.less(m, i, min) {
+			return
+		}
+		mm.data[i], mm.data[m] = mm.data[m], mm.data[i]
+		if m <= 2*i+2 {
+			return
+		}
+		if p := (m - 1) / 2; mm.less(p, m, min) {
+			mm.data[m], mm.data[p] = mm.data[p], mm.data[m]
+		}
+		i = m
+	}
+}
+
+//@title    maxIndex
+//@description
+//		以minMaxHeap容器做接收者
+//		返回最大元素所在的位置,即根节点的两个子节点中较大者
+//		只有一个元素时为根节点,容器为空时返回-1
+//@receiver		mm			*minMaxHeap				接受者minMaxHeap的指针
+//@param    	nil
+//@return    	idx			int						最大元素所在的位置
+func (mm *minMaxHeap) maxIndex() (idx int) {
+	switch len(mm.data) {
+	case 0:
+		return -1
+	case 1:
+		return 0
+	case 2:
+		return 1
+	}
+	if mm.cmp(mm.data[2], mm.data[1]) > 0 {
+		return 2
+	}
+	return 1
+}
+
+//@title    removeAt
+//@description
+//		以minMaxHeap容器做接收者
+//		删除位置i的元素并返回,将末尾元素移至该位置后进行下沉
+//@receiver		mm			*minMaxHeap				接受者minMaxHeap的指针
+//@param    	i			int						待删除元素的位置
+//@return    	e			interface{}				被删除的元素
+func (mm *minMaxHeap) removeAt(i int) (e interface{}) {
+	e = mm.data[i]
+	last := len(mm.data) - 1
+	mm.data[i] = mm.data[last]
+	mm.data[last] = nil
+	mm.data = mm.data[:last]
+	if i < last {
+		mm.down(i)
+	}
+	return e
+}
+
+//@title    PopMin
+//@description
+//		以minMaxHeap容器做接收者
+//		弹出并返回最小元素
+//		如果容器不存在或容器为空,返回nil
+//@receiver		mm			*minMaxHeap				接受者minMaxHeap的指针
+//@param    	nil
+//@return    	e			interface{}				最小元素
+func (mm *minMaxHeap) PopMin() (e interface{}) {
+	if mm == nil {
+		return nil
+	}
+	mm.mutex.Lock()
+	if len(mm.data) > 0 {
+		e = mm.removeAt(0)
+	}
+	mm.mutex.Unlock()
+	return e
+}
+
+//@title    PopMax
+//@description
+//		以minMaxHeap容器做接收者
+//		弹出并返回最大元素
+//		如果容器不存在或容器为空,返回nil
+//@receiver		mm			*minMaxHeap				接受者minMaxHeap的指针
+//@param    	nil
+//@return    	e			interface{}				最大元素
+func (mm *minMaxHeap) PopMax() (e interface{}) {
+	if mm == nil {
+		return nil
+	}
+	mm.mutex.Lock()
+	if idx := mm.maxIndex(); idx >= 0 {
+		e = mm.removeAt(idx)
+	}
+	mm.mutex.Unlock()
+	return e
+}
+
+//@title    Min
+//@description
+//		以minMaxHeap容器做接收者
+//		返回最小元素
+//		如果容器不存在或容器为空,返回nil
+//@receiver		mm			*minMaxHeap				接受者minMaxHeap的指针
+//@param    	nil
+//@return    	e			interface{}				最小元素
+func (mm *minMaxHeap) Min() (e interface{}) {
+	if mm == nil {
+		return nil
+	}
+	mm.mutex.Lock()
+	if len(mm.data) > 0 {
+		e = mm.data[0]
+	}
+	mm.mutex.Unlock()
+	return e
+}
+
+//@title    Max
+//@description
+//		以minMaxHeap容器做接收者
+//		返回最大元素
+//		如果容器不存在或容器为空,返回nil
+//@receiver		mm			*minMaxHeap				接受者minMaxHeap的指针
+//@param    	nil
+//@return    	e			interface{}				最大元素
+func (mm *minMaxHeap) Max() (e interface{}) {
+	if mm == nil {
+		return nil
+	}
+	mm.mutex.Lock()
+	if idx := mm.maxIndex(); idx >= 0 {
+		e = mm.data[idx]
+	}
+	mm.mutex.Unlock()
+	return e
+}
@@ -0,0 +1,109 @@
+package minMaxHeap
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func intCmp(a, b interface{}) int {
+	x, y := a.(int), b.(int)
+	if x < y {
+		return -1
+	}
+	if x > y {
+		return 1
+	}
+	return 0
+}
+
+//checkLevels检查偶数层节点不大于其子孙,奇数层节点不小于其子孙
+func checkLevels(t *testing.T, mm *minMaxHeap) {
+	for i := 1; i < len(mm.data); i++ {
+		for p := (i - 1) / 2; ; p = (p - 1) / 2 {
+			c := mm.cmp(mm.data[p], mm.data[i])
+			if isMinLevel(p) && c > 0 || !isMinLevel(p) && c < 0 {
+				t.Fatalf("node %d (%v) breaks the order of ancestor %d (%v)", i, mm.data[i], p, mm.data[p])
+			}
+			if p == 0 {
+				break
+			}
+		}
+	}
+}
+
+func TestMinMaxModel(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for round := 0; round < 50; round++ {
+		init := make([]interface{}, 0, 0)
+		model := make([]int, 0, 0)
+		for i := r.Intn(100); i > 0; i-- {
+			v := r.Intn(200)
+			init = append(init, v)
+			model = append(model, v)
+		}
+		mm := FromSlice(init, intCmp)
+		for it := 0; it < 500; it++ {
+			checkLevels(t, mm)
+			sort.Ints(model)
+			if mm.Size() != len(model) {
+				t.Fatalf("Size = %d, want %d", mm.Size(), len(model))
+			}
+			if len(model) == 0 {
+				if mm.Min() != nil || mm.Max() != nil || mm.PopMin() != nil || mm.PopMax() != nil {
+					t.Fatal("empty heap returned an element")
+				}
+			} else if mm.Min() != model[0] || mm.Max() != model[len(model)-1] {
+				t.Fatalf("Min/Max = %v/%v, want %d/%d", mm.Min(), mm.Max(), model[0], model[len(model)-1])
+			}
+			switch r.Intn(4) {
+			case 0, 1:
+				v := r.Intn(200)
+				mm.PushBack(v)
+				model = append(model, v)
+			case 2:
+				if len(model) > 0 {
+					if e := mm.PopMin(); e != model[0] {
+						t.Fatalf("PopMin = %v, want %d", e, model[0])
+					}
+					model = model[1:]
+				}
+			case 3:
+				if len(model) > 0 {
+					if e := mm.PopMax(); e != model[len(model)-1] {
+						t.Fatalf("PopMax = %v, want %d", e, model[len(model)-1])
+					}
+					model = model[:len(model)-1]
+				}
+			}
+		}
+	}
+}
+
+func TestSmallHeaps(t *testing.T) {
+	//元素较少时最大元素可能位于根或第1层的任一节点,两端交替弹出
+	for n := 1; n <= 7; n++ {
+		for round := 0; round < 20; round++ {
+			mm := New(intCmp)
+			for _, v := range rand.New(rand.NewSource(int64(round))).Perm(n) {
+				mm.PushBack(v)
+			}
+			for lo, hi := 0, n-1; lo <= hi; {
+				if (lo+hi+round)%2 == 0 {
+					if e := mm.PopMax(); e != hi {
+						t.Fatalf("n=%d: PopMax = %v, want %d", n, e, hi)
+					}
+					hi--
+				} else {
+					if e := mm.PopMin(); e != lo {
+						t.Fatalf("n=%d: PopMin = %v, want %d", n, e, lo)
+					}
+					lo++
+				}
+			}
+			if !mm.Empty() {
+				t.Fatalf("n=%d: heap not empty after popping every element", n)
+			}
+		}
+	}
+}
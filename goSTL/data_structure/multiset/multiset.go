@@ -147,28 +147,22 @@ func (ms *multiset) Insert(e interface{}) {
 		return
 	}
 	ms.mutex.Lock()
-	if ms.Empty() {
-		ms.data = append(ms.data, e)
-	} else {
-		if ms.cmp == nil {
-			ms.cmp = comparator.GetCmp(e)
-		}
-		if ms.cmp == nil {
-			return
-		}
-		begin := ms.Iterator().Begin()
-		end := ms.Iterator().End()
-		p := algorithm.LowerBound(begin, end, e, ms.cmp)
-		if p == len(ms.data)-1 {
-			ms.data = append(ms.data, e)
-		} else if p == 0 {
-			es := append([]interface{}{}, e)
-			ms.data = append(es, ms.data...)
-		} else {
-			es := append([]interface{}{}, ms.data[p:]...)
-			ms.data = append(append(ms.data[:p], e), es...)
-		}
+	if ms.cmp == nil {
+		ms.cmp = comparator.GetCmp(e)
+	}
+	if ms.cmp == nil {
+		ms.mutex.Unlock()
+		return
+	}
+	//在相等元素之后插入,保证相等元素按插入顺序排列
+	i := iterator.New(ms.data)
+	_, p := algorithm.EqualRange(i.Begin(), i.End(), e, ms.cmp)
+	if p < 0 {
+		p = 0
 	}
+	ms.data = append(ms.data, nil)
+	copy(ms.data[p+1:], ms.data[p:])
+	ms.data[p] = e
 	ms.mutex.Unlock()
 }
 
@@ -185,10 +179,11 @@ func (ms *multiset) Erase(e interface{}) {
 		return
 	}
 	ms.mutex.Lock()
-	p := algorithm.Search(ms.Iterator().Begin(), ms.Iterator().End(), e, ms.cmp)
+	i := iterator.New(ms.data)
+	p := algorithm.Search(i.Begin(), i.End(), e, ms.cmp)
 	if p != -1 {
 		if len(ms.data) == 1 {
-			ms.Clear()
+			ms.data = ms.data[0:0]
 		} else {
 			if p == 0 {
 				ms.data = ms.data[1:]
@@ -214,12 +209,9 @@ func (ms *multiset) Count(e interface{}) (num int) {
 		return 0
 	}
 	ms.mutex.Lock()
-	upper := algorithm.UpperBound(ms.Iterator().Begin(), ms.Iterator().End(), e, ms.cmp)
-	if e != ms.data[upper] {
-		return 0
-	}
-	lower := algorithm.LowerBound(ms.Iterator().Begin(), ms.Iterator().Get(upper), e, ms.cmp)
-	num = upper - lower + 1
+	i := iterator.New(ms.data)
+	lower, upper := algorithm.EqualRange(i.Begin(), i.End(), e, ms.cmp)
+	num = upper - lower
 	if num <= 0 {
 		num = 0
 	}
@@ -241,10 +233,11 @@ func (ms *multiset) Find(e interface{}) (i *iterator.Iterator) {
 		return nil
 	}
 	ms.mutex.Lock()
-	p := algorithm.Search(ms.Iterator().Begin(), ms.Iterator().End(), e, ms.cmp)
+	i = iterator.New(ms.data)
+	p := algorithm.Search(i.Begin(), i.End(), e, ms.cmp)
 	if p != -1 {
 		ms.mutex.Unlock()
-		return ms.Iterator().Get(p)
+		return i.Get(p)
 	}
 	ms.mutex.Unlock()
 	return nil
@@ -0,0 +1,84 @@
+package multiset
+
+import "testing"
+
+type record struct {
+	key int
+	tag string
+}
+
+func recordCmp(a, b interface{}) int {
+	x, y := a.(record).key, b.(record).key
+	if x < y {
+		return -1
+	}
+	if x > y {
+		return 1
+	}
+	return 0
+}
+
+func TestCountFind(t *testing.T) {
+	ms := New(recordCmp)
+	//乱序插入,相等的元素按插入顺序排列
+	for _, r := range []record{{7, "f"}, {3, "b"}, {9, "h"}, {3, "c"}, {1, "a"}, {5, "e"}, {7, "g"}, {3, "d"}} {
+		ms.Insert(r)
+	}
+	tests := []struct {
+		name  string
+		key   int
+		count int
+		tag   string
+	}{
+		{"absent before first", 0, 0, ""},
+		{"first", 1, 1, "a"},
+		{"duplicate run", 3, 3, "b"},
+		{"absent inside", 4, 0, ""},
+		{"single in middle", 5, 1, "e"},
+		{"duplicate run before last", 7, 2, "f"},
+		{"last", 9, 1, "h"},
+		{"absent after last", 10, 0, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := record{key: tt.key}
+			if got := ms.Count(e); got != tt.count {
+				t.Errorf("Count = %d, want %d", got, tt.count)
+			}
+			i := ms.Find(e)
+			if tt.count == 0 {
+				if i != nil {
+					t.Errorf("Find = %v, want nil", i.Value())
+				}
+				return
+			}
+			if i == nil || i.Value().(record).tag != tt.tag {
+				t.Errorf("Find did not return the first %d tagged %q", tt.key, tt.tag)
+			}
+		})
+	}
+	want := "abcdefgh"
+	got := ""
+	for i := ms.Iterator(); i.HasNext(); i.Next() {
+		got += i.Value().(record).tag
+	}
+	if got != want {
+		t.Errorf("order = %s, want %s", got, want)
+	}
+}
+
+func TestEraseOne(t *testing.T) {
+	ms := New(recordCmp)
+	for _, r := range []record{{3, "b"}, {3, "c"}, {1, "a"}} {
+		ms.Insert(r)
+	}
+	ms.Erase(record{key: 3})
+	if got := ms.Count(record{key: 3}); got != 1 {
+		t.Fatalf("Count = %d after Erase, want 1", got)
+	}
+	ms.Erase(record{key: 3})
+	ms.Erase(record{key: 1})
+	if !ms.Empty() {
+		t.Fatalf("Size = %d, want 0", ms.Size())
+	}
+}
@@ -0,0 +1,445 @@
+package pairingHeap
+
+//@Title		pairingHeap
+//@Description
+//		配对堆-Pairing Heap
+//		以多叉树的形式实现的可合并堆,每个节点以左孩子右兄弟的方式保存其子节点
+//		插入和合并只需比较两个堆顶,时间复杂度为O(1)
+//		弹出堆顶时对其子节点进行两趟配对合并,均摊时间复杂度为O(log n)
+//		插入时返回元素的句柄,可通过句柄减小元素,均摊时间复杂度为o(log n),实际使用中近似O(1)
+//		若使用默认比较器,顶端元素是最小元素
+import (
+	"github.com/hlccd/goSTL/utils/comparator"
+	"github.com/hlccd/goSTL/utils/iterator"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+//pairingHeap配对堆结构体
+//该实例存储配对堆的根节点及元素数量
+//比较器在创建时传入,若不传入则在插入首个元素时从默认比较器中寻找
+type pairingHeap struct {
+	root  *Handle               //根节点指针
+	size  int                   //存储元素数量
+	cmp   comparator.Comparator //比较器
+	own   *owner                //归属标识,该配对堆插入的句柄均指向它
+	mutex sync.Mutex            //并发控制锁
+}
+
+//Handle句柄结构体
+//即配对堆中的节点,插入元素时返回
+//child为首个子节点,next为下一个兄弟节点
+//prev对于首个子节点指向其父节点,对于其他节点指向上一个兄弟节点
+//元素被弹出后in置为false,该句柄随之失效
+type Handle struct {
+	value interface{}    //节点中存储的元素
+	child *Handle        //首个子节点
+	next  *Handle        //下一个兄弟节点
+	prev  *Handle        //父节点或上一个兄弟节点
+	own   unsafe.Pointer //所属堆的归属标识,类型为*owner,通过原子操作读写
+	in    bool           //该节点是否仍在堆中
+}
+
+//owner归属标识结构体
+//每个配对堆持有一个归属标识,被合并时将原标识的to指向合并入的配对堆的标识,并换上新的标识
+//句柄沿to找到的最终标识即为其当前所属配对堆的标识,据此拒绝其他配对堆的句柄
+type owner struct {
+	to unsafe.Pointer //被合并入的配对堆的归属标识,类型为*owner,通过原子操作读写
+}
+
+//pairingHeap配对堆容器接口
+//存放了pairingHeap配对堆可使用的函数
+//对应函数介绍见下方
+type pairingHeaper interface {
+	Iterator() (i *iterator.Iterator)               //返回包含该配对堆的所有元素
+	Size() (num int)                                //返回该配对堆中保存的元素个数
+	Clear()                                         //清空该配对堆
+	Empty() (b bool)                                //判断该配对堆是否为空
+	Push(e interface{}) (hd *Handle)                //向配对堆中插入元素e并返回其句柄
+	Pop()                                           //弹出顶部元素
+	Top() (e interface{})                           //返回顶部元素
+	Merge(other *pairingHeap)                       //将另一个配对堆的全部元素移入该配对堆
+	DecreaseKey(hd *Handle, e interface{}) (b bool) //将句柄对应的元素减小为e
+	Contains(hd *Handle) (b bool)                   //判断句柄对应的元素是否仍在堆中
+}
+
+//@title    New
+//@description
+//		新建一个pairingHeap配对堆容器并返回
+//		初始根节点为nil
+//		若有传入的比较器,则将传入的第一个比较器设为该配对堆的比较器
+//@receiver		nil
+//@param    	Cmp			 ...comparator.Comparator	pairingHeap比较器集
+//@return    	ph        	*pairingHeap				新建的pairingHeap指针
+func New(Cmp ...comparator.Comparator) (ph *pairingHeap) {
+	var cmp comparator.Comparator
+	if len(Cmp) > 0 {
+		cmp = Cmp[0]
+	}
+	return &pairingHeap{
+		root:  nil,
+		size:  0,
+		cmp:   cmp,
+		own:   &owner{},
+		mutex: sync.Mutex{},
+	}
+}
+
+//@title    Value
+//@description
+//		以Handle句柄做接收者
+//		返回句柄对应的元素
+//@receiver		hd			*Handle					接受者Handle的指针
+//@param    	nil
+//@return    	e 			interface{}				句柄对应的元素
+func (hd *Handle) Value() (e interface{}) {
+	if hd == nil {
+		return nil
+	}
+	return hd.value
+}
+
+//@title    each
+//@description
+//		以Handle句柄做接收者
+//		以该节点为根进行前序遍历,对每个节点调用fn
+//		使用栈代替递归,避免退化为长链时栈过深
+//@receiver		hd			*Handle					接受者Handle的指针
+//@param    	fn			func(n *Handle)			遍历函数
+//@return    	nil
+func (hd *Handle) each(fn func(n *Handle)) {
+	if hd == nil {
+		return
+	}
+	stack := []*Handle{hd}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		fn(n)
+		for c := n.child; c != nil; c = c.next {
+			stack = append(stack, c)
+		}
+	}
+}
+
+//@title    Iterator
+//@description
+//		以pairingHeap配对堆做接收者
+//		将该配对堆中所有保存的元素从根节点开始以前序遍历的形式放入迭代器中
+//		除首个元素为顶部元素外,其余元素之间没有确定的顺序
+//@receiver		ph			*pairingHeap			接受者pairingHeap的指针
+//@param    	nil
+//@return    	i        	*iterator.Iterator		新建的Iterator迭代器指针
+func (ph *pairingHeap) Iterator() (i *iterator.Iterator) {
+	if ph == nil {
+		return iterator.New(make([]interface{}, 0, 0))
+	}
+	ph.mutex.Lock()
+	es := make([]interface{}, 0, ph.size)
+	ph.root.each(func(n *Handle) {
+		es = append(es, n.value)
+	})
+	i = iterator.New(es)
+	ph.mutex.Unlock()
+	return i
+}
+
+//@title    Size
+//@description
+//		以pairingHeap配对堆做接收者
+//		返回该容器当前含有元素的数量
+//		如果容器为nil返回-1
+//@receiver		ph			*pairingHeap			接受者pairingHeap的指针
+//@param    	nil
+//@return    	num        	int						容器中实际使用元素所占空间大小
+func (ph *pairingHeap) Size() (num int) {
+	if ph == nil {
+		return -1
+	}
+	return ph.size
+}
+
+//@title    Clear
+//@description
+//		以pairingHeap配对堆做接收者
+//		将该容器中所承载的元素清空,并使全部句柄失效
+//@receiver		ph			*pairingHeap			接受者pairingHeap的指针
+//@param    	nil
+//@return    	nil
+func (ph *pairingHeap) Clear() {
+	if ph == nil {
+		return
+	}
+	ph.mutex.Lock()
+	ph.root.each(func(n *Handle) {
+		n.in = false
+	})
+	ph.root = nil
+	ph.size = 0
+	ph.mutex.Unlock()
+}
+
+//@title    Empty
+//@description
+//		以pairingHeap配对堆做接收者
+//		判断该配对堆是否含有元素
+//		如果含有元素则不为空,返回false
+//		如果不含有元素则说明为空,返回true
+//		如果容器不存在,返回true
+//@receiver		ph			*pairingHeap			接受者pairingHeap的指针
+//@param    	nil
+//@return    	b			bool					该容器是空的吗?
+func (ph *pairingHeap) Empty() (b bool) {
+	if ph == nil {
+		return true
+	}
+	return ph.size <= 0
+}
+
+//@title    meld
+//@description
+//		以pairingHeap配对堆做接收者
+//		合并以a和以b为根的两棵树并返回新的根
+//		较大的根成为较小的根的首个子节点
+//@receiver		ph			*pairingHeap			接受者pairingHeap的指针
+//@param    	a			*Handle					根节点a
+//@param    	b			*Handle					根节点b
+//@return    	r 			*Handle					合并后的根节点
+func (ph *pairingHeap) meld(a, b *Handle) (r *Handle) {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if ph.cmp(b.value, a.value) < 0 {
+		a, b = b, a
+	}
+	b.prev = a
+	b.next = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+	a.child = b
+	return a
+}
+
+//@title    Push
+//@description
+//		以pairingHeap配对堆做接收者
+//		以元素e新建一个单节点的树并与根节点合并
+//		返回该元素的句柄,若无法确定比较器则不插入并返回nil
+//@receiver		ph			*pairingHeap			接受者pairingHeap的指针
+//@param    	e			interface{}				待插入元素
+//@return    	hd			*Handle					该元素的句柄
+func (ph *pairingHeap) Push(e interface{}) (hd *Handle) {
+	if ph == nil {
+		return nil
+	}
+	ph.mutex.Lock()
+	if ph.cmp == nil {
+		ph.cmp = comparator.GetCmp(e)
+	}
+	if ph.cmp == nil {
+		ph.mutex.Unlock()
+		return nil
+	}
+	hd = &Handle{value: e, own: unsafe.Pointer(ph.own), in: true}
+	ph.root = ph.meld(ph.root, hd)
+	ph.size++
+	ph.mutex.Unlock()
+	return hd
+}
+
+//@title    Pop
+//@description
+//		以pairingHeap配对堆做接收者
+//		删除根节点,并将其全部子节点进行两趟配对合并
+//		第一趟从左到右将子节点两两合并,第二趟从右到左将合并结果依次合并
+//@receiver		ph			*pairingHeap			接受者pairingHeap的指针
+//@param    	nil
+//@return    	nil
+func (ph *pairingHeap) Pop() {
+	if ph == nil {
+		return
+	}
+	ph.mutex.Lock()
+	if ph.root == nil {
+		ph.mutex.Unlock()
+		return
+	}
+	old := ph.root
+	//第一趟,两两合并
+	pairs := make([]*Handle, 0, 0)
+	for c := old.child; c != nil; {
+		a, b := c, c.next
+		c = nil
+		if b != nil {
+			c = b.next
+			b.prev, b.next = nil, nil
+		}
+		a.prev, a.next = nil, nil
+		pairs = append(pairs, ph.meld(a, b))
+	}
+	//第二趟,从右到左依次合并
+	var r *Handle
+	for i := len(pairs) - 1; i >= 0; i-- {
+		r = ph.meld(pairs[i], r)
+	}
+	ph.root = r
+	old.child, old.in = nil, false
+	ph.size--
+	ph.mutex.Unlock()
+}
+
+//@title    Top
+//@description
+//		以pairingHeap配对堆做接收者
+//		返回该配对堆的顶部元素
+//		当该配对堆不存在或为空时返回nil
+//@receiver		ph			*pairingHeap			接受者pairingHeap的指针
+//@param    	nil
+//@return    	e 			interface{}				该配对堆的顶部元素
+func (ph *pairingHeap) Top() (e interface{}) {
+	if ph == nil {
+		return nil
+	}
+	ph.mutex.Lock()
+	if ph.root != nil {
+		e = ph.root.value
+	}
+	ph.mutex.Unlock()
+	return e
+}
+
+//@title    lockPair
+//@description
+//		按地址顺序对配对堆a和b加锁
+//		两个配对堆相互合并时加锁顺序一致,不会死锁
+//@receiver		nil
+//@param    	a			*pairingHeap			待加锁的配对堆
+//@param    	b			*pairingHeap			待加锁的配对堆
+//@return    	nil
+func lockPair(a, b *pairingHeap) {
+	if uintptr(unsafe.Pointer(a)) > uintptr(unsafe.Pointer(b)) {
+		a, b = b, a
+	}
+	a.mutex.Lock()
+	b.mutex.Lock()
+}
+
+//@title    Merge
+//@description
+//		以pairingHeap配对堆做接收者
+//		将另一个配对堆的全部元素移入该配对堆,移入后另一个配对堆为空
+//		只需合并两个根节点,时间复杂度为O(1)
+//		另一个配对堆中元素的句柄在该配对堆中依然有效,另一个配对堆换上新的归属标识后不再接受这些句柄
+//		合并时同时持有两个配对堆的锁,归属标识通过原子操作读写,合并期间其他配对堆判断这些句柄也不会产生竞争
+//		若两者为同一个配对堆则不做合并
+//@receiver		ph			*pairingHeap			接受者pairingHeap的指针
+//@param    	other		*pairingHeap			待合并的配对堆
+//@return    	nil
+func (ph *pairingHeap) Merge(other *pairingHeap) {
+	if ph == nil || other == nil || ph == other {
+		return
+	}
+	//同时持有两个配对堆的锁,使归属标识在转移过程中不会被另一个配对堆访问
+	lockPair(ph, other)
+	root, size := other.root, other.size
+	if root == nil {
+		other.mutex.Unlock()
+		ph.mutex.Unlock()
+		return
+	}
+	other.root, other.size = nil, 0
+	atomic.StorePointer(&other.own.to, unsafe.Pointer(ph.own))
+	other.own = &owner{}
+	if ph.cmp == nil {
+		ph.cmp = other.cmp
+	}
+	ph.root = ph.meld(ph.root, root)
+	ph.size += size
+	other.mutex.Unlock()
+	ph.mutex.Unlock()
+}
+
+//@title    DecreaseKey
+//@description
+//		以pairingHeap配对堆做接收者
+//		将句柄对应的元素减小为e
+//		将该节点及其子树从其父节点中剪下,再与根节点合并即可
+//		句柄已失效或e大于原元素时不做修改并返回false
+//		句柄需属于该配对堆或已合并入该配对堆的配对堆,否则不做修改并返回false
+//@receiver		ph			*pairingHeap			接受者pairingHeap的指针
+//@param    	hd			*Handle					元素的句柄
+//@param    	e			interface{}				新元素
+//@return    	b			bool					是否修改成功?
+func (ph *pairingHeap) DecreaseKey(hd *Handle, e interface{}) (b bool) {
+	if ph == nil || hd == nil {
+		return false
+	}
+	ph.mutex.Lock()
+	if !hd.in || !ph.owns(hd) || ph.cmp(e, hd.value) > 0 {
+		ph.mutex.Unlock()
+		return false
+	}
+	hd.value = e
+	if hd != ph.root {
+		//从父节点或兄弟节点链表中剪下该节点
+		if hd.prev.child == hd {
+			hd.prev.child = hd.next
+		} else {
+			hd.prev.next = hd.next
+		}
+		if hd.next != nil {
+			hd.next.prev = hd.prev
+		}
+		hd.prev, hd.next = nil, nil
+		ph.root = ph.meld(ph.root, hd)
+	}
+	ph.mutex.Unlock()
+	return true
+}
+
+//@title    owns
+//@description
+//		以pairingHeap配对堆做接收者
+//		判断句柄是否属于该配对堆,即由该配对堆或已合并入该配对堆的配对堆插入
+//		沿归属标识的to找到最终标识,途中将经过的标识改为指向其后第二个标识,并将最终标识记回句柄以缩短后续查找
+//		标识和句柄中的指针均通过原子操作读写,且只会改为指向同一链上更靠后的标识,其他配对堆同时判断该句柄时不会出错
+//		需在持有锁时调用
+//@receiver		ph			*pairingHeap			接受者pairingHeap的指针
+//@param    	hd			*Handle					元素的句柄
+//@return    	b			bool					该句柄属于该配对堆?
+func (ph *pairingHeap) owns(hd *Handle) (b bool) {
+	o := (*owner)(atomic.LoadPointer(&hd.own))
+	if o == nil {
+		return false
+	}
+	for n := (*owner)(atomic.LoadPointer(&o.to)); n != nil; n = (*owner)(atomic.LoadPointer(&o.to)) {
+		if nn := atomic.LoadPointer(&n.to); nn != nil {
+			atomic.StorePointer(&o.to, nn)
+		}
+		o = n
+	}
+	atomic.StorePointer(&hd.own, unsafe.Pointer(o))
+	return o == ph.own
+}
+
+//@title    Contains
+//@description
+//		以pairingHeap配对堆做接收者
+//		判断句柄对应的元素是否仍在该堆中,即属于该配对堆且尚未被弹出
+//@receiver		ph			*pairingHeap			接受者pairingHeap的指针
+//@param    	hd			*Handle					元素的句柄
+//@return    	b			bool					该元素仍在堆中?
+func (ph *pairingHeap) Contains(hd *Handle) (b bool) {
+	if ph == nil || hd == nil {
+		return false
+	}
+	ph.mutex.Lock()
+	b = hd.in && ph.owns(hd)
+	ph.mutex.Unlock()
+	return b
+}
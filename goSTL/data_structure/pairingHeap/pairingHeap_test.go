@@ -0,0 +1,175 @@
+package pairingHeap
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+//item以key比较,key相同时以id区分,使堆中元素两两不等,便于确定被弹出的句柄
+type item struct {
+	key, id int
+}
+
+func itemCmp(a, b interface{}) int {
+	x, y := a.(item), b.(item)
+	switch {
+	case x.key < y.key:
+		return -1
+	case x.key > y.key:
+		return 1
+	case x.id < y.id:
+		return -1
+	case x.id > y.id:
+		return 1
+	}
+	return 0
+}
+
+//minOf返回参照模型中最小元素的句柄
+func minOf(model map[*Handle]item) (hd *Handle) {
+	for h, v := range model {
+		if hd == nil || itemCmp(v, model[hd]) < 0 {
+			hd = h
+		}
+	}
+	return hd
+}
+
+func TestMergeModel(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	hs := make([]*pairingHeap, 4)
+	models := make([]map[*Handle]item, 4)
+	all := make([]*Handle, 0, 0)
+	for i := range hs {
+		hs[i], models[i] = New(itemCmp), map[*Handle]item{}
+		v := item{key: r.Intn(1000), id: -1 - i}
+		hd := hs[i].Push(v)
+		models[i][hd] = v
+		all = append(all, hd)
+	}
+	for it := 0; it < 20000; it++ {
+		i := r.Intn(len(hs))
+		switch r.Intn(8) {
+		case 0, 1, 2:
+			v := item{key: r.Intn(1000), id: it}
+			hd := hs[i].Push(v)
+			models[i][hd] = v
+			all = append(all, hd)
+		case 3, 4:
+			//句柄可能来自任意一个堆,也可能已被弹出
+			hd := all[r.Intn(len(all))]
+			v, ok := models[i][hd]
+			nv := item{key: v.key - r.Intn(50), id: v.id}
+			if !ok {
+				nv = item{key: -1, id: -1}
+			}
+			if got := hs[i].DecreaseKey(hd, nv); got != ok {
+				t.Fatalf("DecreaseKey on heap %d = %v, want %v", i, got, ok)
+			}
+			if ok {
+				models[i][hd] = nv
+				if hs[i].DecreaseKey(hd, item{key: nv.key + 1, id: nv.id}) {
+					t.Fatal("DecreaseKey accepted a larger key")
+				}
+			}
+		case 5:
+			j := r.Intn(len(hs))
+			hs[i].Merge(hs[j])
+			if i != j {
+				for hd, v := range models[j] {
+					models[i][hd] = v
+				}
+				models[j] = map[*Handle]item{}
+			}
+		case 6, 7:
+			if hs[i].Empty() {
+				continue
+			}
+			hd := minOf(models[i])
+			if got := hs[i].Top(); got != models[i][hd] {
+				t.Fatalf("Top of heap %d = %v, want %v", i, got, models[i][hd])
+			}
+			hs[i].Pop()
+			delete(models[i], hd)
+			if hs[i].Contains(hd) {
+				t.Fatal("popped handle is still contained")
+			}
+		}
+		if hs[i].Size() != len(models[i]) {
+			t.Fatalf("Size of heap %d = %d, want %d", i, hs[i].Size(), len(models[i]))
+		}
+		hd := all[r.Intn(len(all))]
+		for k := range hs {
+			if _, want := models[k][hd]; hs[k].Contains(hd) != want {
+				t.Fatalf("Contains on heap %d = %v, want %v", k, !want, want)
+			}
+		}
+	}
+	for i := range hs {
+		for !hs[i].Empty() {
+			hd := minOf(models[i])
+			if got := hs[i].Top(); got != models[i][hd] {
+				t.Fatalf("drain of heap %d: Top = %v, want %v", i, got, models[i][hd])
+			}
+			hs[i].Pop()
+			delete(models[i], hd)
+		}
+		if len(models[i]) != 0 {
+			t.Fatalf("heap %d is empty but the model still has %d elements", i, len(models[i]))
+		}
+	}
+}
+
+func TestClearInvalidatesHandles(t *testing.T) {
+	h := New(itemCmp)
+	hd := h.Push(item{key: 1})
+	h.Clear()
+	if h.Contains(hd) || h.DecreaseKey(hd, item{key: 0}) {
+		t.Fatal("handle survived Clear")
+	}
+	//合并入的空堆不改变归属
+	o := New(itemCmp)
+	hd = o.Push(item{key: 2})
+	h.Merge(New(itemCmp))
+	if h.Contains(hd) || !o.Contains(hd) {
+		t.Fatal("merging an empty heap moved a foreign handle")
+	}
+}
+
+func TestConcurrentMerge(t *testing.T) {
+	const n = 200
+	dst := New(itemCmp)
+	hds := make(chan *Handle, n)
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < n; i++ {
+			src := New(itemCmp)
+			hds <- src.Push(item{key: i, id: i})
+			dst.Merge(src)
+		}
+		close(hds)
+	}()
+	go func() {
+		defer wg.Done()
+		//其他堆在合并期间查询句柄,不应误判归属
+		other := New(itemCmp)
+		for hd := range hds {
+			if other.Contains(hd) || other.DecreaseKey(hd, item{key: -1}) {
+				t.Error("foreign heap claimed a handle")
+			}
+		}
+	}()
+	wg.Wait()
+	if dst.Size() != n {
+		t.Fatalf("Size = %d, want %d", dst.Size(), n)
+	}
+	for i := 0; i < n; i++ {
+		if got := dst.Top().(item); got.key != i {
+			t.Fatalf("Top = %v, want key %d", got, i)
+		}
+		dst.Pop()
+	}
+}
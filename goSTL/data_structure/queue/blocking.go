@@ -0,0 +1,394 @@
+package queue
+
+//@Title		queue
+//@Description
+//		blocking阻塞队列
+//		以queue队列或heap堆作为底层容器,在其上增加容量限制和阻塞等待
+//		队列满时放入元素会等待直至有空余,队列空时取出元素会等待直至有元素
+//		等待过程可通过context取消,关闭队列后将唤醒所有等待者
+//		适用于生产者-消费者模型,消费者无需轮询队列
+import (
+	"context"
+	"errors"
+	"github.com/hlccd/goSTL/data_structure/heap"
+	"github.com/hlccd/goSTL/utils/comparator"
+	"sync"
+)
+
+//阻塞队列的错误
+var (
+	ErrClosed       = errors.New("queue: closed")                 //队列已关闭
+	ErrFull         = errors.New("queue: full")                   //队列已满
+	ErrEmpty        = errors.New("queue: empty")                  //队列为空
+	ErrUncomparable = errors.New("queue: element not comparable") //元素无法比较,未能放入
+)
+
+//blocking阻塞队列结构体
+//push、pop和size为对底层容器的操作,在持有锁时调用
+//takers和putters为等待者的唤醒通道,每个等待者持有一个通道并按等待顺序排列
+//每放入或取出一个元素只唤醒一个对应的等待者,无人等待时不做任何操作
+type blocking struct {
+	push     func(e interface{}) (ok bool) //向底层容器放入元素,失败时返回false
+	pop      func() (e interface{})        //从底层容器取出元素
+	size     func() (num int)              //底层容器中的元素数量
+	capacity int                           //容量上限,不大于0时不限制
+	closed   bool                          //是否已关闭
+	takers   []chan struct{}               //等待取出的调用者的唤醒通道
+	putters  []chan struct{}               //等待放入的调用者的唤醒通道
+	mutex    sync.Mutex                    //并发控制锁
+}
+
+//blocking阻塞队列接口
+//存放了blocking阻塞队列可使用的函数
+//对应函数介绍见下方
+type blockinger interface {
+	Size() (num int)                                     //返回队列中元素的数量
+	Empty() (b bool)                                     //判断队列是否为空
+	Put(ctx context.Context, e interface{}) (err error)  //放入元素e,队列满时等待
+	Take(ctx context.Context) (e interface{}, err error) //取出元素,队列空时等待
+	TryPut(e interface{}) (err error)                    //尝试放入元素e,不等待
+	TryTake() (e interface{}, err error)                 //尝试取出元素,不等待
+	Close()                                              //关闭队列并唤醒所有等待者
+	Drain() (es []interface{})                           //取出队列中的全部元素
+}
+
+//@title    NewBlocking
+//@description
+//		新建一个以queue队列为底层容器的阻塞队列并返回
+//		元素按先进先出的顺序取出
+//		capacity为容量上限,不大于0时不限制容量,此时放入元素不会等待
+//@receiver		nil
+//@param    	capacity	int						容量上限
+//@return    	b        	*blocking				新建的blocking指针
+func NewBlocking(capacity int) (b *blocking) {
+	q := New()
+	return newBlocking(capacity, func(e interface{}) (ok bool) {
+		q.Push(e)
+		return true
+	}, q.Pop, q.Size)
+}
+
+//@title    NewBlockingPriority
+//@description
+//		新建一个以heap堆为底层容器的阻塞优先队列并返回
+//		元素按堆顶优先的顺序取出,若使用默认比较器则每次取出最小的元素
+//		capacity为容量上限,不大于0时不限制容量,此时放入元素不会等待
+//		若未传入比较器且无法由元素确定默认比较器,则该元素不会被放入,放入时返回ErrUncomparable
+//@receiver		nil
+//@param    	capacity	int							容量上限
+//@param    	Cmp			...comparator.Comparator	heap的比较器集
+//@return    	b        	*blocking					新建的blocking指针
+func NewBlockingPriority(capacity int, Cmp ...comparator.Comparator) (b *blocking) {
+	h := heap.New(Cmp...)
+	return newBlocking(capacity, func(e interface{}) (ok bool) {
+		return h.Push(e) != nil
+	}, func() (e interface{}) {
+		e = h.Top()
+		h.Pop()
+		return e
+	}, h.Size)
+}
+
+//@title    newBlocking
+//@description
+//		以传入的底层容器操作新建一个阻塞队列并返回
+//@receiver		nil
+//@param    	capacity	int							容量上限
+//@param    	push		func(e interface{}) bool	向底层容器放入元素
+//@param    	pop			func() (e interface{})		从底层容器取出元素
+//@param    	size		func() (num int)			底层容器中的元素数量
+//@return    	b        	*blocking					新建的blocking指针
+func newBlocking(capacity int, push func(e interface{}) (ok bool), pop func() (e interface{}), size func() (num int)) (b *blocking) {
+	return &blocking{
+		push:     push,
+		pop:      pop,
+		size:     size,
+		capacity: capacity,
+		closed:   false,
+		takers:   make([]chan struct{}, 0, 0),
+		putters:  make([]chan struct{}, 0, 0),
+		mutex:    sync.Mutex{},
+	}
+}
+
+//@title    wake
+//@description
+//		唤醒ws中最早等待的一个调用者,并将其唤醒通道移出
+//		若无人等待则不做任何操作
+//		需在持有锁时调用
+//@receiver		nil
+//@param    	ws			*[]chan struct{}		等待者的唤醒通道
+//@return    	nil
+func wake(ws *[]chan struct{}) {
+	if len(*ws) == 0 {
+		return
+	}
+	close((*ws)[0])
+	(*ws)[0] = nil
+	*ws = (*ws)[1:]
+}
+
+//@title    wakeAll
+//@description
+//		唤醒ws中全部等待的调用者,并清空唤醒通道
+//		需在持有锁时调用
+//@receiver		nil
+//@param    	ws			*[]chan struct{}		等待者的唤醒通道
+//@return    	nil
+func wakeAll(ws *[]chan struct{}) {
+	for i := 0; i < len(*ws); i++ {
+		close((*ws)[i])
+	}
+	*ws = make([]chan struct{}, 0, 0)
+}
+
+//@title    leave
+//@description
+//		放弃等待的调用者将其唤醒通道ch从ws中移出
+//		若ch已不在ws中,说明其已被唤醒,此时将唤醒转交给下一个等待者,避免唤醒丢失
+//		需在持有锁时调用
+//@receiver		nil
+//@param    	ws			*[]chan struct{}		等待者的唤醒通道
+//@param    	ch			chan struct{}			放弃等待的调用者的唤醒通道
+//@return    	nil
+func leave(ws *[]chan struct{}, ch chan struct{}) {
+	for i := 0; i < len(*ws); i++ {
+		if (*ws)[i] == ch {
+			*ws = append((*ws)[:i], (*ws)[i+1:]...)
+			return
+		}
+	}
+	wake(ws)
+}
+
+//@title    Size
+//@description
+//		以blocking阻塞队列做接收者
+//		返回该队列当前含有元素的数量
+//		当队列不存在时,返回-1
+//@receiver		b			*blocking				接受者blocking的指针
+//@param    	nil
+//@return    	num        	int						队列中存储元素的个数
+func (b *blocking) Size() (num int) {
+	if b == nil {
+		return -1
+	}
+	b.mutex.Lock()
+	num = b.size()
+	b.mutex.Unlock()
+	return num
+}
+
+//@title    Empty
+//@description
+//		以blocking阻塞队列做接收者
+//		判断该队列是否含有元素
+//		如果队列不存在,返回true
+//@receiver		b			*blocking				接受者blocking的指针
+//@param    	nil
+//@return    	b			bool					该队列是空的吗?
+func (b *blocking) Empty() bool {
+	if b == nil {
+		return true
+	}
+	return b.Size() <= 0
+}
+
+//@title    tryPut
+//@description
+//		以blocking阻塞队列做接收者
+//		尝试放入元素e,成功后唤醒等待取出的调用者
+//		需在持有锁时调用
+//@receiver		b			*blocking				接受者blocking的指针
+//@param    	e			interface{}				待放入元素
+//@return    	err			error					队列已关闭时返回ErrClosed,已满时返回ErrFull,元素无法比较时返回ErrUncomparable
+func (b *blocking) tryPut(e interface{}) (err error) {
+	if b.closed {
+		return ErrClosed
+	}
+	if b.capacity > 0 && b.size() >= b.capacity {
+		return ErrFull
+	}
+	if !b.push(e) {
+		return ErrUncomparable
+	}
+	wake(&b.takers)
+	return nil
+}
+
+//@title    tryTake
+//@description
+//		以blocking阻塞队列做接收者
+//		尝试取出元素,成功后唤醒等待放入的调用者
+//		队列关闭后依然可以取出剩余的元素
+//		需在持有锁时调用
+//@receiver		b			*blocking				接受者blocking的指针
+//@param    	nil
+//@return    	e			interface{}				取出的元素
+//@return    	err			error					队列为空且已关闭时返回ErrClosed,为空时返回ErrEmpty
+func (b *blocking) tryTake() (e interface{}, err error) {
+	if b.size() > 0 {
+		e = b.pop()
+		wake(&b.putters)
+		return e, nil
+	}
+	if b.closed {
+		return nil, ErrClosed
+	}
+	return nil, ErrEmpty
+}
+
+//@title    Put
+//@description
+//		以blocking阻塞队列做接收者
+//		放入元素e,队列已满时等待直至有空余
+//		等待期间ctx被取消则放弃放入并返回ctx的错误
+//		队列已关闭或在等待期间被关闭时返回ErrClosed,元素无法比较时返回ErrUncomparable
+//@receiver		b			*blocking				接受者blocking的指针
+//@param    	ctx			context.Context			用于取消等待的上下文
+//@param    	e			interface{}				待放入元素
+//@return    	err			error					放入失败的原因
+func (b *blocking) Put(ctx context.Context, e interface{}) (err error) {
+	if b == nil {
+		return ErrClosed
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	for {
+		b.mutex.Lock()
+		err = b.tryPut(e)
+		if err != ErrFull {
+			b.mutex.Unlock()
+			return err
+		}
+		wait := make(chan struct{})
+		b.putters = append(b.putters, wait)
+		b.mutex.Unlock()
+		select {
+		case <-ctx.Done():
+			b.mutex.Lock()
+			leave(&b.putters, wait)
+			b.mutex.Unlock()
+			return ctx.Err()
+		case <-wait:
+		}
+	}
+}
+
+//@title    Take
+//@description
+//		以blocking阻塞队列做接收者
+//		取出一个元素,队列为空时等待直至有元素
+//		等待期间ctx被取消则放弃取出并返回ctx的错误
+//		队列关闭后依然可以取出剩余的元素,取完后返回ErrClosed
+//@receiver		b			*blocking				接受者blocking的指针
+//@param    	ctx			context.Context			用于取消等待的上下文
+//@return    	e			interface{}				取出的元素
+//@return    	err			error					取出失败的原因
+func (b *blocking) Take(ctx context.Context) (e interface{}, err error) {
+	if b == nil {
+		return nil, ErrClosed
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	for {
+		b.mutex.Lock()
+		e, err = b.tryTake()
+		if err != ErrEmpty {
+			b.mutex.Unlock()
+			return e, err
+		}
+		wait := make(chan struct{})
+		b.takers = append(b.takers, wait)
+		b.mutex.Unlock()
+		select {
+		case <-ctx.Done():
+			b.mutex.Lock()
+			leave(&b.takers, wait)
+			b.mutex.Unlock()
+			return nil, ctx.Err()
+		case <-wait:
+		}
+	}
+}
+
+//@title    TryPut
+//@description
+//		以blocking阻塞队列做接收者
+//		尝试放入元素e,不进行等待
+//@receiver		b			*blocking				接受者blocking的指针
+//@param    	e			interface{}				待放入元素
+//@return    	err			error					队列已关闭时返回ErrClosed,已满时返回ErrFull,元素无法比较时返回ErrUncomparable
+func (b *blocking) TryPut(e interface{}) (err error) {
+	if b == nil {
+		return ErrClosed
+	}
+	b.mutex.Lock()
+	err = b.tryPut(e)
+	b.mutex.Unlock()
+	return err
+}
+
+//@title    TryTake
+//@description
+//		以blocking阻塞队列做接收者
+//		尝试取出一个元素,不进行等待
+//@receiver		b			*blocking				接受者blocking的指针
+//@param    	nil
+//@return    	e			interface{}				取出的元素
+//@return    	err			error					队列为空且已关闭时返回ErrClosed,为空时返回ErrEmpty
+func (b *blocking) TryTake() (e interface{}, err error) {
+	if b == nil {
+		return nil, ErrClosed
+	}
+	b.mutex.Lock()
+	e, err = b.tryTake()
+	b.mutex.Unlock()
+	return e, err
+}
+
+//@title    Close
+//@description
+//		以blocking阻塞队列做接收者
+//		关闭该队列并唤醒所有等待放入和取出的调用者
+//		关闭后不可再放入元素,但可以继续取出剩余的元素
+//		重复关闭不做任何操作
+//@receiver		b			*blocking				接受者blocking的指针
+//@param    	nil
+//@return    	nil
+func (b *blocking) Close() {
+	if b == nil {
+		return
+	}
+	b.mutex.Lock()
+	if !b.closed {
+		b.closed = true
+		wakeAll(&b.takers)
+		wakeAll(&b.putters)
+	}
+	b.mutex.Unlock()
+}
+
+//@title    Drain
+//@description
+//		以blocking阻塞队列做接收者
+//		按取出顺序取出该队列中的全部元素并返回,不进行等待
+//		每取出一个元素唤醒一个等待放入的调用者
+//@receiver		b			*blocking				接受者blocking的指针
+//@param    	nil
+//@return    	es			[]interface{}			取出的全部元素
+func (b *blocking) Drain() (es []interface{}) {
+	es = make([]interface{}, 0, 0)
+	if b == nil {
+		return es
+	}
+	b.mutex.Lock()
+	for b.size() > 0 {
+		es = append(es, b.pop())
+		wake(&b.putters)
+	}
+	b.mutex.Unlock()
+	return es
+}
@@ -0,0 +1,162 @@
+package queue
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBlockingOrder(t *testing.T) {
+	b := NewBlocking(0)
+	for i := 0; i < 10; i++ {
+		if err := b.Put(context.Background(), i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 0; i < 10; i++ {
+		e, err := b.Take(context.Background())
+		if err != nil || e != i {
+			t.Fatalf("take %d: got %v, %v", i, e, err)
+		}
+	}
+	if _, err := b.TryTake(); err != ErrEmpty {
+		t.Fatalf("want ErrEmpty, got %v", err)
+	}
+}
+
+func TestBlockingPriorityUncomparable(t *testing.T) {
+	b := NewBlockingPriority(0)
+	if err := b.TryPut(struct{}{}); err != ErrUncomparable {
+		t.Fatalf("want ErrUncomparable, got %v", err)
+	}
+	if err := b.Put(context.Background(), struct{}{}); err != ErrUncomparable {
+		t.Fatalf("want ErrUncomparable, got %v", err)
+	}
+	if b.Size() != 0 {
+		t.Fatalf("size %d after failed put", b.Size())
+	}
+	for _, v := range []int{3, 1, 2} {
+		if err := b.TryPut(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, want := range []int{1, 2, 3} {
+		if e, err := b.TryTake(); err != nil || e != want {
+			t.Fatalf("want %d, got %v, %v", want, e, err)
+		}
+	}
+}
+
+func TestBlockingCapacity(t *testing.T) {
+	b := NewBlocking(1)
+	if err := b.TryPut(1); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.TryPut(2); err != ErrFull {
+		t.Fatalf("want ErrFull, got %v", err)
+	}
+	done := make(chan error)
+	go func() {
+		done <- b.Put(context.Background(), 2)
+	}()
+	select {
+	case err := <-done:
+		t.Fatalf("put returned %v on a full queue", err)
+	case <-time.After(20 * time.Millisecond):
+	}
+	if e, _ := b.Take(context.Background()); e != 1 {
+		t.Fatalf("want 1, got %v", e)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if e, _ := b.Take(context.Background()); e != 2 {
+		t.Fatalf("want 2, got %v", e)
+	}
+}
+
+func TestBlockingCancel(t *testing.T) {
+	b := NewBlocking(0)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := b.Take(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("want DeadlineExceeded, got %v", err)
+	}
+	if len(b.takers) != 0 {
+		t.Fatalf("%d takers left after cancel", len(b.takers))
+	}
+}
+
+func TestBlockingClose(t *testing.T) {
+	b := NewBlocking(0)
+	var wg sync.WaitGroup
+	errs := make(chan error, 4)
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := b.Take(context.Background())
+			errs <- err
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	b.Close()
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != ErrClosed {
+			t.Fatalf("want ErrClosed, got %v", err)
+		}
+	}
+	if err := b.TryPut(1); err != ErrClosed {
+		t.Fatalf("want ErrClosed, got %v", err)
+	}
+}
+
+func TestBlockingProducerConsumer(t *testing.T) {
+	const producers, per = 4, 1000
+	b := NewBlocking(8)
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < per; i++ {
+				if err := b.Put(context.Background(), p*per+i); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}(p)
+	}
+	seen := make([]bool, producers*per)
+	var mu sync.Mutex
+	var cg sync.WaitGroup
+	for c := 0; c < 4; c++ {
+		cg.Add(1)
+		go func() {
+			defer cg.Done()
+			for {
+				e, err := b.Take(context.Background())
+				if err == ErrClosed {
+					return
+				}
+				mu.Lock()
+				if seen[e.(int)] {
+					t.Errorf("%d taken twice", e)
+				}
+				seen[e.(int)] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	b.Close()
+	cg.Wait()
+	for i, ok := range seen {
+		if !ok {
+			t.Fatalf("%d lost", i)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package queue
+
+//@Title		queue
+//@Description
+//		lockFree无锁队列
+//		以Michael-Scott算法实现的多生产者多消费者队列
+//		以带哨兵节点的单向链表存储元素,首尾指针均通过CAS原子操作修改而不使用互斥锁
+//		在大量协程并发放入和取出时可避免锁竞争
+//		该容器满足FIFO的先进先出模式
+//		可接纳不同类型的元素
+import (
+	"sync/atomic"
+	"unsafe"
+)
+
+//lockFree无锁队列结构体
+//head指向哨兵节点,哨兵节点之后的节点依次存储队列中的元素
+//tail指向尾节点或其前一个节点,落后时由后续操作协助后移
+//num为元素数量,仅在操作完成后原子增减,并发时为近似值
+type lockFree struct {
+	head unsafe.Pointer //哨兵节点指针,类型为*lockFreeNode
+	tail unsafe.Pointer //尾节点指针,类型为*lockFreeNode
+	num  int64          //元素数量
+}
+
+//lockFreeNode无锁队列节点结构体
+//next通过原子操作读写
+type lockFreeNode struct {
+	value interface{}    //节点中存储的元素
+	next  unsafe.Pointer //后一个节点指针,类型为*lockFreeNode
+}
+
+//lockFree无锁队列接口
+//存放了lockFree容器可使用的函数
+//对应函数介绍见下方
+type lockFreeer interface {
+	Size() (num int)      //返回该队列中元素的数量
+	Empty() (b bool)      //判断该队列是否为空
+	Push(e interface{})   //将元素e添加到该队列末尾
+	Pop() (e interface{}) //将该队列首元素弹出并返回
+}
+
+//@title    NewLockFree
+//@description
+//		新建一个lockFree无锁队列容器并返回
+//		初始时首尾指针均指向同一个哨兵节点
+//@receiver		nil
+//@param    	nil
+//@return    	q        	*lockFree				新建的lockFree指针
+func NewLockFree() (q *lockFree) {
+	n := unsafe.Pointer(&lockFreeNode{})
+	return &lockFree{
+		head: n,
+		tail: n,
+		num:  0,
+	}
+}
+
+//@title    loadNode
+//@description
+//		原子地读取节点指针
+//@receiver		nil
+//@param    	p			*unsafe.Pointer			待读取的指针地址
+//@return    	n			*lockFreeNode			读取到的节点
+func loadNode(p *unsafe.Pointer) (n *lockFreeNode) {
+	return (*lockFreeNode)(atomic.LoadPointer(p))
+}
+
+//@title    casNode
+//@description
+//		原子地比较并交换节点指针
+//		当指针仍为old时将其修改为n并返回true,否则返回false
+//@receiver		nil
+//@param    	p			*unsafe.Pointer			待修改的指针地址
+//@param    	old			*lockFreeNode			期望的原节点
+//@param    	n			*lockFreeNode			修改后的节点
+//@return    	b			bool					是否修改成功
+func casNode(p *unsafe.Pointer, old, n *lockFreeNode) (b bool) {
+	return atomic.CompareAndSwapPointer(p, unsafe.Pointer(old), unsafe.Pointer(n))
+}
+
+//@title    Size
+//@description
+//		以lockFree无锁队列做接收者
+//		返回该队列当前含有元素的数量
+//		并发放入和取出时该值仅为近似值
+//		由于元素数量在链接节点之后才增加,并发时可能短暂为负,此时返回0
+//		当容器为nil时返回-1
+//@receiver		q			*lockFree				接受者lockFree的指针
+//@param    	nil
+//@return    	num        	int						容器中存储元素的个数
+func (q *lockFree) Size() (num int) {
+	if q == nil {
+		return -1
+	}
+	num = int(atomic.LoadInt64(&q.num))
+	if num < 0 {
+		return 0
+	}
+	return num
+}
+
+//@title    Empty
+//@description
+//		以lockFree无锁队列做接收者
+//		判断该队列是否含有元素
+//		通过哨兵节点之后是否存在节点进行判断
+//		如果容器不存在,返回true
+//@receiver		q			*lockFree				接受者lockFree的指针
+//@param    	nil
+//@return    	b			bool					该容器是空的吗?
+func (q *lockFree) Empty() (b bool) {
+	if q == nil {
+		return true
+	}
+	return loadNode(&loadNode(&q.head).next) == nil
+}
+
+//@title    Push
+//@description
+//		以lockFree无锁队列做接收者
+//		将元素e添加到该队列末尾
+//		先通过CAS将新节点链接到尾节点之后,再尝试将尾指针后移到新节点
+//		若发现尾指针落后,则先协助将其后移再重试
+//@receiver		q			*lockFree				接受者lockFree的指针
+//@param    	e			interface{}				待插入元素
+//@return    	nil
+func (q *lockFree) Push(e interface{}) {
+	if q == nil {
+		return
+	}
+	n := &lockFreeNode{value: e}
+	for {
+		tail := loadNode(&q.tail)
+		next := loadNode(&tail.next)
+		if tail != loadNode(&q.tail) {
+			continue
+		}
+		if next != nil {
+			casNode(&q.tail, tail, next)
+			continue
+		}
+		if casNode(&tail.next, nil, n) {
+			casNode(&q.tail, tail, n)
+			break
+		}
+	}
+	atomic.AddInt64(&q.num, 1)
+}
+
+//@title    Pop
+//@description
+//		以lockFree无锁队列做接收者
+//		弹出该队列的首元素并返回
+//		通过CAS将哨兵指针后移一位,原首元素所在节点成为新的哨兵节点
+//		若尾指针落后于哨兵节点,则先协助将其后移再重试
+//		若该队列为空,则返回nil
+//		被取出元素所在节点成为新的哨兵节点,其中的元素在下一次取出之前不会被回收
+//@receiver		q			*lockFree				接受者lockFree的指针
+//@param    	nil
+//@return    	e			interface{}				队首元素
+func (q *lockFree) Pop() (e interface{}) {
+	if q == nil {
+		return nil
+	}
+	for {
+		head := loadNode(&q.head)
+		tail := loadNode(&q.tail)
+		next := loadNode(&head.next)
+		if head != loadNode(&q.head) {
+			continue
+		}
+		if next == nil {
+			return nil
+		}
+		if head == tail {
+			casNode(&q.tail, tail, next)
+			continue
+		}
+		e = next.value
+		if casNode(&q.head, head, next) {
+			atomic.AddInt64(&q.num, -1)
+			return e
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package queue
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestLockFreeMPMC(t *testing.T) {
+	const producers, consumers, per = 4, 4, 5000
+	q := NewLockFree()
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < per; i++ {
+				q.Push([2]int{p, i})
+			}
+		}(p)
+	}
+	got := make([][][2]int, consumers)
+	var cg sync.WaitGroup
+	var mu sync.Mutex
+	taken := 0
+	for c := 0; c < consumers; c++ {
+		cg.Add(1)
+		go func(c int) {
+			defer cg.Done()
+			for {
+				mu.Lock()
+				done := taken == producers*per
+				mu.Unlock()
+				if done {
+					return
+				}
+				e := q.Pop()
+				if e == nil {
+					continue
+				}
+				got[c] = append(got[c], e.([2]int))
+				mu.Lock()
+				taken++
+				mu.Unlock()
+			}
+		}(c)
+	}
+	wg.Wait()
+	cg.Wait()
+	seen := make([][]bool, producers)
+	for p := range seen {
+		seen[p] = make([]bool, per)
+	}
+	for c := range got {
+		last := make([]int, producers)
+		for p := range last {
+			last[p] = -1
+		}
+		for _, e := range got[c] {
+			p, i := e[0], e[1]
+			if seen[p][i] {
+				t.Fatalf("element %v popped twice", e)
+			}
+			seen[p][i] = true
+			if i <= last[p] {
+				t.Fatalf("consumer %d: producer %d element %d after %d", c, p, i, last[p])
+			}
+			last[p] = i
+		}
+	}
+	for p := range seen {
+		for i, ok := range seen[p] {
+			if !ok {
+				t.Fatalf("element %v lost", [2]int{p, i})
+			}
+		}
+	}
+	if !q.Empty() || q.Size() != 0 {
+		t.Fatalf("queue not empty: size %d", q.Size())
+	}
+}
+
+func BenchmarkLockFree(b *testing.B) {
+	q := NewLockFree()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			q.Push(1)
+			q.Pop()
+		}
+	})
+}
+
+func BenchmarkMutex(b *testing.B) {
+	q := New()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			q.Push(1)
+			q.Pop()
+		}
+	})
+}
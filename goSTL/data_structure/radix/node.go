@@ -1,29 +1,281 @@
 package radix
 
+//@Title		radix
+//@Description
+//		基数树的节点
+//		每个节点对应从其父节点到达该节点所经过的一段字节,即压缩后的边
+//		子节点按边的首字节升序存放,同一节点的子节点的边首字节互不相同
+//		除根节点外,不承载元素的节点至少有两个子节点,否则将与其唯一的子节点合并
+import (
+	"sort"
+	"strings"
+)
+
+//node树节点结构体
+//该节点是基数树的树节点
+//path为从父节点到达该节点的边,根节点的path为空串
+//num为以该节点为前缀的key的数量
+//value不为nil时说明有key恰好结束于该节点
 type node struct {
-	name  string
-	num   int
-	value interface{}
-	son   []*node
+	path  string      //从父节点到达该节点所经过的字节
+	num   int         //以该节点为前缀的key的数量
+	value interface{} //该节点中存储的元素
+	son   []*node     //按边首字节升序存放的子节点
 }
 
-func newNode(name string, e interface{}) (n *node) {
+//@title    newNode
+//@description
+//		新建一个基数树节点并返回
+//		将传入的字符串作为到达该节点的边,传入的元素e作为该节点的承载元素
+//		初始时不含有子节点
+//@receiver		nil
+//@param    	path		string					到达该节点的边
+//@param    	e			interface{}				承载元素e
+//@return    	n        	*node					新建的基数树节点的指针
+func newNode(path string, e interface{}) (n *node) {
 	return &node{
-		name:  name,
+		path:  path,
 		num:   0,
 		value: e,
 		son:   make([]*node, 0, 0),
 	}
 }
-func (n *node) inOrder(s string) (es []interface{}) {
-	if n == nil {
-		return es
+
+//@title    commonPrefix
+//@description
+//		返回字符串a和b的最长公共前缀的长度
+//@receiver		nil
+//@param    	a			string					字符串a
+//@param    	b			string					字符串b
+//@return    	l        	int						最长公共前缀的长度
+func commonPrefix(a, b string) (l int) {
+	for l < len(a) && l < len(b) && a[l] == b[l] {
+		l++
 	}
+	return l
+}
+
+//@title    search
+//@description
+//		以node基数树节点做接收者
+//		二分查找边首字节为c的子节点应处的位置
+//		返回该位置以及该位置上的子节点的边首字节是否恰好为c
+//@receiver		n			*node					接受者node的指针
+//@param    	c			byte					待查找的字节
+//@return    	idx        	int						子节点应处的位置
+//@return    	ok        	bool					该子节点存在?
+func (n *node) search(c byte) (idx int, ok bool) {
+	idx = sort.Search(len(n.son), func(i int) bool {
+		return n.son[i].path[0] >= c
+	})
+	return idx, idx < len(n.son) && n.son[idx].path[0] == c
+}
+
+//@title    merge
+//@description
+//		以node基数树节点做接收者
+//		若该节点不承载元素且只有一个子节点,则将该子节点合并进该节点
+//		合并后该节点的边为两段边的拼接,元素和子节点均来自原子节点
+//		根节点不可调用该函数
+//@receiver		n			*node					接受者node的指针
+//@param    	nil
+//@return    	nil
+func (n *node) merge() {
+	if n.value != nil || len(n.son) != 1 {
+		return
+	}
+	m := n.son[0]
+	n.path = n.path + m.path
+	n.value = m.value
+	n.son = m.son
+}
+
+//@title    removeAt
+//@description
+//		以node基数树节点做接收者
+//		删除下标为idx的子节点
+//@receiver		n			*node					接受者node的指针
+//@param    	idx			int						待删除子节点的下标
+//@return    	nil
+func (n *node) removeAt(idx int) {
+	copy(n.son[idx:], n.son[idx+1:])
+	n.son[len(n.son)-1] = nil
+	n.son = n.son[:len(n.son)-1]
+}
+
+//@title    insert
+//@description
+//		以node基数树节点做接收者
+//		将key的剩余部分s及元素e插入以该节点为根的子树中
+//		若s与某子节点的边仅有部分公共前缀,则在公共前缀处将该边分裂为两段
+//		若key已存在则覆盖其元素,此时不改变key的数量
+//@receiver		n			*node					接受者node的指针
+//@param    	s			string					key的剩余部分
+//@param    	e			interface{}				待插入元素,不为nil
+//@return    	b        	bool					是否新增了key
+func (n *node) insert(s string, e interface{}) (b bool) {
+	if s == "" {
+		b = n.value == nil
+		n.value = e
+		if b {
+			n.num++
+		}
+		return b
+	}
+	idx, ok := n.search(s[0])
+	if !ok {
+		m := newNode(s, e)
+		m.num = 1
+		n.son = append(n.son, nil)
+		copy(n.son[idx+1:], n.son[idx:])
+		n.son[idx] = m
+		n.num++
+		return true
+	}
+	m := n.son[idx]
+	l := commonPrefix(m.path, s)
+	if l < len(m.path) {
+		//分裂边,公共前缀部分作为新的中间节点
+		mid := newNode(m.path[:l], nil)
+		mid.num = m.num
+		m.path = m.path[l:]
+		mid.son = append(mid.son, m)
+		n.son[idx] = mid
+		m = mid
+	}
+	if b = m.insert(s[l:], e); b {
+		n.num++
+	}
+	return b
+}
+
+//@title    erase
+//@description
+//		以node基数树节点做接收者
+//		从以该节点为根的子树中删除剩余部分为s的key
+//		删除后不再含有key的子节点将被移除,只剩一个子节点且不承载元素的子节点将与其子节点合并
+//@receiver		n			*node					接受者node的指针
+//@param    	s			string					key的剩余部分
+//@return    	b        	bool					是否删除了key
+func (n *node) erase(s string) (b bool) {
+	if s == "" {
+		if n.value == nil {
+			return false
+		}
+		n.value = nil
+		n.num--
+		return true
+	}
+	idx, ok := n.search(s[0])
+	if !ok {
+		return false
+	}
+	m := n.son[idx]
+	if !strings.HasPrefix(s, m.path) || !m.erase(s[len(m.path):]) {
+		return false
+	}
+	n.num--
+	if m.num == 0 {
+		n.removeAt(idx)
+	} else {
+		m.merge()
+	}
+	return true
+}
+
+//@title    erasePrefix
+//@description
+//		以node基数树节点做接收者
+//		从以该节点为根的子树中删除剩余部分以p为前缀的全部key
+//		p不为空串,p可以在某条边的中间结束,此时删除该边所指向的整个子树
+//@receiver		n			*node					接受者node的指针
+//@param    	p			string					前缀的剩余部分
+//@return    	num        	int						删除的key的数量
+func (n *node) erasePrefix(p string) (num int) {
+	idx, ok := n.search(p[0])
+	if !ok {
+		return 0
+	}
+	m := n.son[idx]
+	if strings.HasPrefix(m.path, p) {
+		num = m.num
+		n.removeAt(idx)
+	} else if strings.HasPrefix(p, m.path) {
+		num = m.erasePrefix(p[len(m.path):])
+		if m.num == 0 {
+			n.removeAt(idx)
+		} else {
+			m.merge()
+		}
+	}
+	n.num -= num
+	return num
+}
+
+//@title    locate
+//@description
+//		以node基数树节点做接收者
+//		从该节点开始向下查找以p为前缀的全部key所在子树的根节点
+//		p可以在某条边的中间结束,此时返回该边所指向的节点
+//		同时返回从该节点到所找到节点所经过的完整字符串,其以p为前缀
+//		不存在则返回nil
+//@receiver		n			*node					接受者node的指针
+//@param    	p			string					待查找的前缀
+//@return    	m        	*node					子树的根节点
+//@return    	s        	string					到达该节点经过的字符串
+func (n *node) locate(p string) (m *node, s string) {
+	m, s = n, ""
+	for len(s) < len(p) {
+		idx, ok := m.search(p[len(s)])
+		if !ok {
+			return nil, ""
+		}
+		c := m.son[idx]
+		rest := p[len(s):]
+		if !strings.HasPrefix(c.path, rest) && !strings.HasPrefix(rest, c.path) {
+			return nil, ""
+		}
+		m, s = c, s+c.path
+	}
+	return m, s
+}
+
+//@title    find
+//@description
+//		以node基数树节点做接收者
+//		从该节点开始向下查找恰好对应s的节点
+//		不存在或s在某条边的中间结束时返回nil
+//@receiver		n			*node					接受者node的指针
+//@param    	s			string					待查找的字符串
+//@return    	m        	*node					s对应的节点
+func (n *node) find(s string) (m *node) {
+	m, k := n.locate(s)
+	if m == nil || len(k) != len(s) {
+		return nil
+	}
+	return m
+}
+
+//@title    walk
+//@description
+//		以node基数树节点做接收者
+//		按字节升序遍历以该节点为起点的全部key及其元素,并依次调用fn
+//		s为从根节点到该节点所经过的字节组成的前缀
+//		当fn返回false时停止遍历并返回false
+//@receiver		n			*node					接受者node的指针
+//@param    	s			[]byte									到达该节点的前缀
+//@param    	fn			func(key string, value interface{}) bool	遍历函数
+//@return    	b        	bool									是否遍历完毕
+func (n *node) walk(s []byte, fn func(key string, value interface{}) bool) (b bool) {
 	if n.value != nil {
-		es = append(es, s+n.name)
+		if !fn(string(s), n.value) {
+			return false
+		}
 	}
 	for i := 0; i < len(n.son); i++ {
-		es = append(es, n.son[i].inOrder(s+n.name+"/")...)
+		if !n.son[i].walk(append(s, n.son[i].path...), fn) {
+			return false
+		}
 	}
-	return es
+	return true
 }
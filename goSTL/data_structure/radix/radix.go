@@ -1,42 +1,101 @@
 package radix
 
+//@Title		radix
+//@Description
+//		基数树-Radix Tree
+//		即路径压缩的前缀树(PATRICIA),只有一个子节点且不承载元素的节点会与其子节点合并为一条边
+//		key可以是任意字节序列,节点数不超过key数量的两倍,与key的总长度无关
+//		插入时若与已有边仅有部分公共前缀则在公共前缀处分裂该边,删除时将多余的节点重新合并
+//		子节点按边首字节升序存放,故可按字节序遍历全部key
+//		每个节点保存以其为前缀的key的数量,可快速统计某一前缀下的key的数量
+//		插入时若不传入元素,则以key本身作为存储的元素
 import (
 	"github.com/hlccd/goSTL/utils/iterator"
-	"strings"
 	"sync"
 )
 
+//radix基数树结构体
+//该实例存储基数树的根节点
+//根节点的边为空串,其num即为基数树中存储的key的数量
+//routes为以InsertRoute注册的路由所构成的路由树,首次注册时创建
+//路由与key相互独立,routeNum为已注册的路由数量,不计入Size
 type radix struct {
-	root  *node
-	mutex sync.Mutex
+	root     *node      //根节点指针
+	routes   *route     //路由树的根节点指针
+	routeNum int        //已注册的路由数量
+	mutex    sync.Mutex //并发控制锁
 }
 
+//radix基数树容器接口
+//存放了radix基数树可使用的函数
+//对应函数介绍见下方
 type radixer interface {
-	Iterator() (i *iterator.Iterator) //返回包含该树堆的所有元素,重复则返回多个
-	Size() (num int)                  //返回该树堆中保存的元素个数
-	Clear()                           //清空该树堆
-	Empty() (b bool)                  //判断该树堆是否为空
-	Insert(s string, e interface{})   //向树堆中插入元素e
-	Erase(s string)                   //从树堆中删除元素e
-	Count(s string) (num int)         //从树堆中寻找元素e并返回其个数
-	Find(s string) (e interface{})
+	Iterator() (i *iterator.Iterator)                                     //返回包含该基数树的所有key
+	Size() (num int)                                                      //返回该基数树中保存的key的个数
+	Clear()                                                               //清空该基数树
+	Empty() (b bool)                                                      //判断该基数树是否为空
+	Insert(s string, e interface{})                                       //向基数树中插入key为s的元素e
+	Erase(s string)                                                       //从基数树中删除key为s的元素
+	ErasePrefix(p string)                                                 //从基数树中删除以p为前缀的全部key
+	Count(s string) (num int)                                             //返回以s为前缀的key的个数
+	Find(s string) (e interface{})                                        //返回key为s的元素
+	LongestPrefix(s string) (key string, value interface{}, ok bool)      //返回是s的前缀的最长key及其元素
+	WalkPrefix(p string, fn func(key string, value interface{}) bool)     //遍历以p为前缀的全部key及其元素
+	Minimum() (key string, value interface{}, ok bool)                    //返回字节序最小的key及其元素
+	Maximum() (key string, value interface{}, ok bool)                    //返回字节序最大的key及其元素
+	InsertRoute(pattern string, e interface{}) (err error)                //注册路由pattern并存放元素e
+	Match(path string) (e interface{}, params map[string]string, ok bool) //匹配路径并返回元素及捕获的参数
+	RouteCount() (num int)                                                //返回已注册的路由数量
+	ClearRoutes()                                                         //清空已注册的路由
 }
 
+//@title    New
+//@description
+//		新建一个radix基数树容器并返回
+//		初始根节点的边为空串且不承载元素
+//@receiver		nil
+//@param    	nil
+//@return    	t        	*radix						新建的radix指针
 func New() (t *radix) {
 	return &radix{
-		root:  newNode("", nil),
-		mutex: sync.Mutex{},
+		root:     newNode("", nil),
+		routes:   nil,
+		routeNum: 0,
+		mutex:    sync.Mutex{},
 	}
 }
+
+//@title    Iterator
+//@description
+//		以radix基数树做接收者
+//		将该基数树中所有保存的key按字节升序放入迭代器中
+//@receiver		t			*radix					接受者radix的指针
+//@param    	nil
+//@return    	i        	*iterator.Iterator		新建的Iterator迭代器指针
 func (t *radix) Iterator() (i *iterator.Iterator) {
 	if t == nil {
 		return iterator.New(make([]interface{}, 0, 0))
 	}
 	t.mutex.Lock()
-	i = iterator.New(t.root.inOrder(""))
+	es := make([]interface{}, 0, t.root.num)
+	t.root.walk(make([]byte, 0, 0), func(key string, value interface{}) bool {
+		es = append(es, key)
+		return true
+	})
+	i = iterator.New(es)
 	t.mutex.Unlock()
 	return i
 }
+
+//@title    Size
+//@description
+//		以radix基数树做接收者
+//		返回该容器当前含有的key的数量
+//		已注册的路由不计入其中,路由数量见RouteCount
+//		如果容器为nil返回-1
+//@receiver		t			*radix					接受者radix的指针
+//@param    	nil
+//@return    	num        	int						容器中实际使用元素所占空间大小
 func (t *radix) Size() (num int) {
 	if t == nil {
 		return -1
@@ -46,6 +105,15 @@ func (t *radix) Size() (num int) {
 	}
 	return t.root.num
 }
+
+//@title    Clear
+//@description
+//		以radix基数树做接收者
+//		将该容器中所承载的元素清空
+//		已注册的路由不受影响,清空路由见ClearRoutes
+//@receiver		t			*radix					接受者radix的指针
+//@param    	nil
+//@return    	nil
 func (t *radix) Clear() {
 	if t == nil {
 		return
@@ -54,48 +122,56 @@ func (t *radix) Clear() {
 	t.root = newNode("", nil)
 	t.mutex.Unlock()
 }
+
+//@title    Empty
+//@description
+//		以radix基数树做接收者
+//		判断该基数树是否含有元素
+//		如果含有元素则不为空,返回false
+//		如果不含有元素则说明为空,返回true
+//		已注册的路由不计入其中
+//		如果容器不存在,返回true
+//@receiver		t			*radix					接受者radix的指针
+//@param    	nil
+//@return    	b			bool					该容器是空的吗?
 func (t *radix) Empty() (b bool) {
 	if t.Size() > 0 {
 		return false
 	}
 	return true
 }
+
+//@title    Insert
+//@description
+//		以radix基数树做接收者
+//		从根节点开始沿公共前缀向下查找,必要时分裂边,并在s对应的节点中存放元素e
+//		若e为nil则存放s本身
+//		若s已存在则覆盖其元素
+//@receiver		t			*radix					接受者radix的指针
+//@param    	s			string					待插入元素的key
+//@param    	e			interface{}				待插入元素
+//@return    	nil
 func (t *radix) Insert(s string, e interface{}) {
 	//判断容器是否存在
 	if t == nil {
 		return
 	}
-	t.mutex.Lock()
-	now := t.root
-	b := true
-	ss := strings.Split(s, "/")
-	for i := 0; i < len(ss); i++ {
-		if ss[i] != "" {
-			b = true
-			now.num++
-			for j := 0; j < len(now.son); j++ {
-				if now.son[j].name == ss[i] {
-					b = false
-					now = now.son[j]
-				}
-			}
-			if b {
-				now.son = append(now.son, newNode(ss[i], nil))
-				now = now.son[len(now.son)-1]
-				now.name = ss[i]
-			}
-			if i == len(ss)-1 {
-				now.num++
-				if e == nil {
-					now.value = ss[len(ss)-1]
-				} else {
-					now.value = e
-				}
-			}
-		}
+	if e == nil {
+		e = s
 	}
+	t.mutex.Lock()
+	t.root.insert(s, e)
 	t.mutex.Unlock()
 }
+
+//@title    Erase
+//@description
+//		以radix基数树做接收者
+//		删除key为s的元素,以s为前缀的其他key不受影响
+//		删除后将多余的节点与其唯一的子节点合并,以保持路径压缩
+//@receiver		t			*radix					接受者radix的指针
+//@param    	s			string					待删除元素的key
+//@return    	nil
 func (t *radix) Erase(s string) {
 	if t == nil {
 		return
@@ -104,113 +180,189 @@ func (t *radix) Erase(s string) {
 		return
 	}
 	t.mutex.Lock()
-	if s == "" {
+	t.root.erase(s)
+	t.mutex.Unlock()
+}
+
+//@title    ErasePrefix
+//@description
+//		以radix基数树做接收者
+//		删除以p为前缀的全部key
+//		若p为空串则清空整个基数树
+//@receiver		t			*radix					接受者radix的指针
+//@param    	p			string					待删除的前缀
+//@return    	nil
+func (t *radix) ErasePrefix(p string) {
+	if t == nil {
+		return
+	}
+	if t.Empty() {
+		return
+	}
+	t.mutex.Lock()
+	if p == "" {
 		t.root = newNode("", nil)
 	} else {
-		num := 0
-		ss := strings.Split(s, "/")
-		for i := 0; i < len(ss); i++ {
-			if ss[i] == "" {
-				ss = append(ss[:i], ss[i+1:]...)
-				i--
-			}
-		}
-		b := true
-		for i, now := 0, t.root; i < len(ss); i++ {
-			b = true
-			for j := 0; j < len(now.son); j++ {
-				if now.son[j].name == ss[i] {
-					now = now.son[j]
-					num = now.num
-					b = false
-					break
-				}
-			}
-			if b {
-				num = 0
-				break
-			}
-		}
-		if num > 0 {
-			for i, now := 0, t.root; i < len(ss); i++ {
-				now.num -= num
-				if i == len(ss)-1 {
-					for j := 0; j < len(now.son); j++ {
-						if now.son[j].name == ss[i] {
-							now.son = append(now.son[:j], now.son[j+1:]...)
-						}
-					}
-					//now.son = make([]*node, 0, 0)
-				} else {
-					for j := 0; j < len(now.son); j++ {
-						if now.son[j].name == ss[i] {
-							now = now.son[j]
-						}
-					}
-				}
-			}
-		}
+		t.root.erasePrefix(p)
 	}
-
 	t.mutex.Unlock()
 }
+
+//@title    Count
+//@description
+//		以radix基数树做接收者
+//		返回以s为前缀的key的数量
+//		若s为空串则返回全部key的数量
+//@receiver		t			*radix					接受者radix的指针
+//@param    	s			string					待查找的前缀
+//@return    	num			int						以s为前缀的key的数量
 func (t *radix) Count(s string) (num int) {
 	if t == nil {
 		return 0
 	}
 	if t.Empty() {
-		return
+		return 0
 	}
 	t.mutex.Lock()
-	ss := strings.Split(s, "/")
-	b := true
-	for i, now := 0, t.root; i < len(ss); i++ {
-		if ss[i] != "" {
-			b = true
-			for j := 0; j < len(now.son); j++ {
-				if now.son[j].name == ss[i] {
-					now = now.son[j]
-					num = now.num
-					b = false
-					break
-				}
-			}
-			if b {
-				num = 0
-				break
-			}
-		}
+	if now, _ := t.root.locate(s); now != nil {
+		num = now.num
 	}
 	t.mutex.Unlock()
 	return num
 }
+
+//@title    Find
+//@description
+//		以radix基数树做接收者
+//		返回key为s的元素
+//		若不存在该key则返回nil
+//@receiver		t			*radix					接受者radix的指针
+//@param    	s			string					待查找元素的key
+//@return    	e			interface{}				key为s的元素
 func (t *radix) Find(s string) (e interface{}) {
 	if t == nil {
-		return 0
+		return nil
 	}
 	if t.Empty() {
-		return
+		return nil
 	}
 	t.mutex.Lock()
-	ss := strings.Split(s, "/")
-	b := true
-	for i, now := 0, t.root; i < len(ss); i++ {
-		if ss[i] != "" {
-			b = true
-			for j := 0; j < len(now.son); j++ {
-				if now.son[j].name == ss[i] {
-					now = now.son[j]
-					e = now.value
-					b = false
-					break
-				}
-			}
-			if b {
-				e = nil
-				break
-			}
-		}
+	if now := t.root.find(s); now != nil {
+		e = now.value
 	}
 	t.mutex.Unlock()
 	return e
 }
+
+//@title    LongestPrefix
+//@description
+//		以radix基数树做接收者
+//		在基数树中查找是s的前缀的最长key,并返回该key及其元素
+//		key可以等于s本身,若不存在这样的key则ok返回false
+//@receiver		t			*radix					接受者radix的指针
+//@param    	s			string					待匹配的字符串
+//@return    	key			string					最长的前缀key
+//@return    	value		interface{}				该key对应的元素
+//@return    	ok			bool					存在这样的key?
+func (t *radix) LongestPrefix(s string) (key string, value interface{}, ok bool) {
+	if t == nil {
+		return "", nil, false
+	}
+	t.mutex.Lock()
+	now, i := t.root, 0
+	for {
+		if now.value != nil {
+			key, value, ok = s[:i], now.value, true
+		}
+		if i == len(s) {
+			break
+		}
+		idx, has := now.search(s[i])
+		if !has || commonPrefix(now.son[idx].path, s[i:]) < len(now.son[idx].path) {
+			break
+		}
+		now = now.son[idx]
+		i += len(now.path)
+	}
+	t.mutex.Unlock()
+	return key, value, ok
+}
+
+//@title    WalkPrefix
+//@description
+//		以radix基数树做接收者
+//		按字节升序遍历以p为前缀的全部key及其元素,并依次调用fn
+//		当fn返回false时停止遍历
+//		遍历期间持有该基数树的锁,故fn中不可调用该基数树的函数
+//@receiver		t			*radix									接受者radix的指针
+//@param    	p			string									待遍历的前缀
+//@param    	fn			func(key string, value interface{}) bool	遍历函数
+//@return    	nil
+func (t *radix) WalkPrefix(p string, fn func(key string, value interface{}) bool) {
+	if t == nil {
+		return
+	}
+	t.mutex.Lock()
+	if now, s := t.root.locate(p); now != nil {
+		now.walk([]byte(s), fn)
+	}
+	t.mutex.Unlock()
+}
+
+//@title    Minimum
+//@description
+//		以radix基数树做接收者
+//		返回字节序最小的key及其元素
+//		由于节点对应的key小于其子树中的其他key,故从根节点沿首个子节点向下找到首个承载元素的节点即可
+//		若基数树为空则ok返回false
+//@receiver		t			*radix					接受者radix的指针
+//@param    	nil
+//@return    	key			string					最小的key
+//@return    	value		interface{}				该key对应的元素
+//@return    	ok			bool					存在这样的key?
+func (t *radix) Minimum() (key string, value interface{}, ok bool) {
+	if t == nil {
+		return "", nil, false
+	}
+	t.mutex.Lock()
+	now := t.root
+	for now.value == nil && len(now.son) > 0 {
+		now = now.son[0]
+		key += now.path
+	}
+	if now.value != nil {
+		value, ok = now.value, true
+	} else {
+		key = ""
+	}
+	t.mutex.Unlock()
+	return key, value, ok
+}
+
+//@title    Maximum
+//@description
+//		以radix基数树做接收者
+//		返回字节序最大的key及其元素
+//		从根节点沿最后一个子节点一直向下到叶子节点即可,叶子节点必然承载元素
+//		若基数树为空则ok返回false
+//@receiver		t			*radix					接受者radix的指针
+//@param    	nil
+//@return    	key			string					最大的key
+//@return    	value		interface{}				该key对应的元素
+//@return    	ok			bool					存在这样的key?
+func (t *radix) Maximum() (key string, value interface{}, ok bool) {
+	if t == nil {
+		return "", nil, false
+	}
+	t.mutex.Lock()
+	now := t.root
+	for len(now.son) > 0 {
+		now = now.son[len(now.son)-1]
+		key += now.path
+	}
+	if now.value != nil {
+		value, ok = now.value, true
+	}
+	t.mutex.Unlock()
+	return key, value, ok
+}
@@ -0,0 +1,282 @@
+package radix
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+//checkShape检查路径压缩的约束:子节点边非空且按首字节严格升序,
+//非根节点不承载元素时至少有两个子节点,num与子树中的key数量一致
+func checkShape(t *testing.T, n *node, root bool) int {
+	c := 0
+	if n.value != nil {
+		c++
+	}
+	if !root && n.value == nil && len(n.son) < 2 {
+		t.Fatalf("node %q is not compressed: %d sons and no value", n.path, len(n.son))
+	}
+	for i, s := range n.son {
+		if s.path == "" || i > 0 && n.son[i-1].path[0] >= s.path[0] {
+			t.Fatalf("sons of %q are empty or out of order", n.path)
+		}
+		c += checkShape(t, s, false)
+	}
+	if c != n.num {
+		t.Fatalf("node %q: num = %d, want %d", n.path, n.num, c)
+	}
+	return c
+}
+
+func withPrefix(model map[string]interface{}, p string) []string {
+	ks := make([]string, 0, 0)
+	for k := range model {
+		if strings.HasPrefix(k, p) {
+			ks = append(ks, k)
+		}
+	}
+	sort.Strings(ks)
+	return ks
+}
+
+func TestRadixModel(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randKey := func() string {
+		b := make([]byte, r.Intn(6))
+		for i := range b {
+			b[i] = "ab\x00\xff"[r.Intn(4)]
+		}
+		return string(b)
+	}
+	tr := New()
+	model := map[string]interface{}{}
+	for it := 0; it < 20000; it++ {
+		s := randKey()
+		switch r.Intn(8) {
+		case 0, 1, 2:
+			var e interface{}
+			if r.Intn(2) == 0 {
+				e = it
+			}
+			tr.Insert(s, e)
+			if e == nil {
+				e = s
+			}
+			model[s] = e
+		case 3, 4:
+			tr.Erase(s)
+			delete(model, s)
+		case 5:
+			tr.ErasePrefix(s)
+			for _, k := range withPrefix(model, s) {
+				delete(model, k)
+			}
+		}
+		checkShape(t, tr.root, true)
+		if tr.Size() != len(model) {
+			t.Fatalf("Size = %d, want %d", tr.Size(), len(model))
+		}
+		q := randKey()
+		ks := withPrefix(model, q)
+		if tr.Count(q) != len(ks) {
+			t.Fatalf("Count(%q) = %d, want %d", q, tr.Count(q), len(ks))
+		}
+		got := make([]string, 0, 0)
+		tr.WalkPrefix(q, func(key string, value interface{}) bool {
+			if value != model[key] {
+				t.Fatalf("WalkPrefix(%q): %q has %v, want %v", q, key, value, model[key])
+			}
+			got = append(got, key)
+			return true
+		})
+		if !reflect.DeepEqual(got, ks) {
+			t.Fatalf("WalkPrefix(%q) = %q, want %q", q, got, ks)
+		}
+		if got, want := tr.Find(q), model[q]; got != want {
+			t.Fatalf("Find(%q) = %v, want %v", q, got, want)
+		}
+		wk, wok := "", false
+		for i := len(q); i >= 0; i-- {
+			if _, ok := model[q[:i]]; ok {
+				wk, wok = q[:i], true
+				break
+			}
+		}
+		if key, value, ok := tr.LongestPrefix(q); key != wk || ok != wok || ok && value != model[wk] {
+			t.Fatalf("LongestPrefix(%q) = %q, %v, want %q, %v", q, key, ok, wk, wok)
+		}
+		all := withPrefix(model, "")
+		mn, _, ok := tr.Minimum()
+		mx, _, _ := tr.Maximum()
+		if ok != (len(all) > 0) || ok && (mn != all[0] || mx != all[len(all)-1]) {
+			t.Fatalf("Minimum/Maximum = %q/%q, want ends of %q", mn, mx, all)
+		}
+	}
+	want := withPrefix(model, "")
+	got := make([]string, 0, 0)
+	for i := tr.Iterator(); i.HasNext(); i.Next() {
+		got = append(got, i.Value().(string))
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Iterator = %q, want %q", got, want)
+	}
+}
+
+func TestRadixSplitAndMerge(t *testing.T) {
+	tr := New()
+	tr.Insert("romane", 1)
+	tr.Insert("romanus", 2)
+	tr.Insert("romulus", 3)
+	//"rom"处分裂出两条边,"roman"处再分裂一次
+	if len(tr.root.son) != 1 || tr.root.son[0].path != "rom" || len(tr.root.son[0].son) != 2 {
+		t.Fatalf("unexpected shape after split")
+	}
+	tr.Erase("romulus")
+	//删除后"rom"与"an"合并为一条边
+	if len(tr.root.son) != 1 || tr.root.son[0].path != "roman" {
+		t.Fatalf("edge not merged after erase: %q", tr.root.son[0].path)
+	}
+	tr.Erase("roman")
+	if tr.Size() != 2 || tr.Find("romane") != 1 {
+		t.Fatalf("erasing an inner prefix that is not a key changed the tree")
+	}
+	tr.ErasePrefix("roman")
+	if !tr.Empty() || len(tr.root.son) != 0 {
+		t.Fatalf("ErasePrefix left %d keys", tr.Size())
+	}
+}
+
+//routeMatch将分段后的路由与路径逐段比较,作为参照
+//kinds记录匹配时每段的种类,静态段为0,命名参数为1,通配符为2
+func routeMatch(ps, ss []string) (params map[string]string, kinds []int, ok bool) {
+	params = map[string]string{}
+	for i, p := range ps {
+		switch p[0] {
+		case '*':
+			params[p[1:]] = strings.Join(ss[i:], "/")
+			return params, append(kinds, 2), true
+		case ':':
+			if i >= len(ss) {
+				return nil, nil, false
+			}
+			params[p[1:]] = ss[i]
+			kinds = append(kinds, 1)
+		default:
+			if i >= len(ss) || ss[i] != p {
+				return nil, nil, false
+			}
+			kinds = append(kinds, 0)
+		}
+	}
+	return params, kinds, len(ps) == len(ss)
+}
+
+//before判断种类序列a是否优先于b,逐段比较,较短者优先
+func before(a, b []int) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return len(a) < len(b)
+}
+
+func TestRouteModel(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	segs := []string{"a", "b", ":p", ":q", "*w", "*v"}
+	for round := 0; round < 200; round++ {
+		tr := New()
+		tr.Insert("key", nil)
+		routes := map[string]interface{}{}
+		for i := r.Intn(10); i >= 0; i-- {
+			ps := make([]string, r.Intn(4))
+			for j := range ps {
+				ps[j] = segs[r.Intn(len(segs))]
+			}
+			pattern := "/" + strings.Join(ps, "/")
+			err := tr.InsertRoute(pattern, i)
+			if err != nil {
+				if !errors.Is(err, ErrRouteConflict) {
+					t.Fatalf("InsertRoute(%q) = %v, want ErrRouteConflict", pattern, err)
+				}
+				continue
+			}
+			if _, ok := routes[pattern]; ok {
+				t.Fatalf("InsertRoute(%q) registered the same route twice", pattern)
+			}
+			routes[pattern] = i
+		}
+		if tr.RouteCount() != len(routes) || tr.Size() != 1 {
+			t.Fatalf("RouteCount = %d, want %d, Size = %d", tr.RouteCount(), len(routes), tr.Size())
+		}
+		for it := 0; it < 50; it++ {
+			ss := make([]string, r.Intn(5))
+			for j := range ss {
+				c := r.Intn(3)
+				ss[j] = "abc"[c : c+1]
+			}
+			path := "/" + strings.Join(ss, "/")
+			var want interface{}
+			var wantParams map[string]string
+			var best []int
+			for pattern, v := range routes {
+				params, kinds, ok := routeMatch(splitPath(pattern), ss)
+				if ok && (want == nil || before(kinds, best)) {
+					want, wantParams, best = v, params, kinds
+				}
+			}
+			e, params, ok := tr.Match(path)
+			if ok != (want != nil) || e != want || ok && !reflect.DeepEqual(params, wantParams) {
+				t.Fatalf("Match(%q) = %v, %v, %v, want %v, %v", path, e, params, ok, want, wantParams)
+			}
+		}
+		tr.ClearRoutes()
+		if tr.RouteCount() != 0 || tr.Size() != 1 {
+			t.Fatalf("ClearRoutes: RouteCount = %d, Size = %d", tr.RouteCount(), tr.Size())
+		}
+		if _, _, ok := tr.Match("/a"); ok {
+			t.Fatal("Match succeeded after ClearRoutes")
+		}
+	}
+}
+
+func TestRouteConflict(t *testing.T) {
+	tr := New()
+	for _, p := range []string{"/users/:id", "/users/:id/posts", "/static/*file", "/"} {
+		if err := tr.InsertRoute(p, nil); err != nil {
+			t.Fatalf("InsertRoute(%q) = %v", p, err)
+		}
+	}
+	tests := []struct {
+		name    string
+		pattern string
+	}{
+		{"different parameter name", "/users/:name"},
+		{"wildcard beside parameter", "/users/*rest"},
+		{"parameter beside wildcard", "/static/:name"},
+		{"duplicate route", "/users/:id/"},
+		{"empty parameter name", "/x/:"},
+		{"wildcard not last", "/x/*rest/y"},
+		{"duplicate parameter name", "/x/:id/:id"},
+		{"wildcard named like a parameter", "/x/:id/*id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tr.InsertRoute(tt.pattern, nil); !errors.Is(err, ErrRouteConflict) {
+				t.Fatalf("InsertRoute(%q) = %v, want ErrRouteConflict", tt.pattern, err)
+			}
+		})
+	}
+	if tr.RouteCount() != 4 {
+		t.Fatalf("rejected routes changed RouteCount to %d", tr.RouteCount())
+	}
+	if e, params, ok := tr.Match("/static"); !ok || e != "/static/*file" || params["file"] != "" {
+		t.Fatalf("Match(/static) = %v, %v, %v", e, params, ok)
+	}
+	if e, params, ok := tr.Match("//users/42/"); !ok || e != "/users/:id" || params["id"] != "42" {
+		t.Fatalf("Match(//users/42/) = %v, %v, %v", e, params, ok)
+	}
+}
@@ -0,0 +1,318 @@
+package radix
+
+//@Title		radix
+//@Description
+//		基数树的路由匹配
+//		路由以'/'分段,每段可以是静态段、以':'开头的命名参数或以'*'开头的通配符
+//		命名参数匹配任意一个非空段,通配符匹配剩余的全部路径(可以为空),通配符只能作为最后一段
+//		匹配时优先级为静态段>命名参数>通配符,某一分支匹配失败时回退尝试较低优先级的分支
+//		插入时若与已有路由冲突则返回错误,冲突包括:
+//		同一位置的命名参数或通配符名称不同、同一位置同时存在命名参数和通配符、重复注册同一路由
+//		参数名为空、同一路由中参数名重复或通配符不在最后一段时同样返回该错误
+//		路径中的空段将被忽略,即"/a//b/"与"/a/b"等价
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+//ErrRouteConflict路由冲突错误
+//插入的路由与已有路由冲突或格式有误时返回以其为基础的错误
+var ErrRouteConflict = errors.New("radix: route conflict")
+
+//route路由节点结构体
+//static为按段名升序存放的静态子节点
+//param和wildcard分别为命名参数子节点和通配符子节点,同一节点至多存在其中之一
+//name为该节点的段名,对于命名参数和通配符即为其参数名
+//pattern为注册时的路由,仅在终点节点中存放,用于报告冲突
+type route struct {
+	name     string      //段名或参数名
+	pattern  string      //以该节点为终点的路由
+	value    interface{} //以该节点为终点的路由所对应的元素
+	static   []*route    //静态子节点
+	param    *route      //命名参数子节点
+	wildcard *route      //通配符子节点
+}
+
+//@title    newRoute
+//@description
+//		新建一个路由节点并返回
+//@receiver		nil
+//@param    	name		string					段名或参数名
+//@return    	r        	*route					新建的路由节点的指针
+func newRoute(name string) (r *route) {
+	return &route{
+		name:     name,
+		pattern:  "",
+		value:    nil,
+		static:   make([]*route, 0, 0),
+		param:    nil,
+		wildcard: nil,
+	}
+}
+
+//@title    splitPath
+//@description
+//		将路径以'/'分段并去除空段
+//@receiver		nil
+//@param    	path		string					路径
+//@return    	ss        	[]string				分段结果
+func splitPath(path string) (ss []string) {
+	ss = make([]string, 0, 0)
+	for _, s := range strings.Split(path, "/") {
+		if s != "" {
+			ss = append(ss, s)
+		}
+	}
+	return ss
+}
+
+//@title    staticOrNew
+//@description
+//		以route路由节点做接收者
+//		返回段名为s的静态子节点,不存在则新建该子节点并有序插入后返回
+//@receiver		r			*route					接受者route的指针
+//@param    	s			string					段名
+//@return    	m        	*route					对应的子节点
+func (r *route) staticOrNew(s string) (m *route) {
+	idx := sort.Search(len(r.static), func(i int) bool {
+		return r.static[i].name >= s
+	})
+	if idx < len(r.static) && r.static[idx].name == s {
+		return r.static[idx]
+	}
+	m = newRoute(s)
+	r.static = append(r.static, nil)
+	copy(r.static[idx+1:], r.static[idx:])
+	r.static[idx] = m
+	return m
+}
+
+//@title    staticOf
+//@description
+//		以route路由节点做接收者
+//		返回段名为s的静态子节点,不存在则返回nil
+//@receiver		r			*route					接受者route的指针
+//@param    	s			string					段名
+//@return    	m        	*route					对应的子节点
+func (r *route) staticOf(s string) (m *route) {
+	idx := sort.Search(len(r.static), func(i int) bool {
+		return r.static[i].name >= s
+	})
+	if idx < len(r.static) && r.static[idx].name == s {
+		return r.static[idx]
+	}
+	return nil
+}
+
+//@title    insert
+//@description
+//		以route路由节点做接收者
+//		将路由pattern插入以该节点为根的路由树中,ss为pattern分段后的结果
+//		先检查全部冲突,无冲突时才修改路由树,故出错时路由树保持不变
+//@receiver		r			*route					接受者route的指针
+//@param    	pattern		string					路由
+//@param    	ss			[]string				路由分段结果
+//@param    	e			interface{}				路由对应的元素
+//@return    	err        	error					冲突时返回的错误
+func (r *route) insert(pattern string, ss []string, e interface{}) (err error) {
+	//检查格式
+	names := make(map[string]bool)
+	for i, s := range ss {
+		if (s[0] == ':' || s[0] == '*') && len(s) == 1 {
+			return fmt.Errorf("%w: empty name in %q", ErrRouteConflict, pattern)
+		}
+		if s[0] == ':' || s[0] == '*' {
+			if names[s[1:]] {
+				return fmt.Errorf("%w: duplicate name %q in %q", ErrRouteConflict, s[1:], pattern)
+			}
+			names[s[1:]] = true
+		}
+		if s[0] == '*' && i != len(ss)-1 {
+			return fmt.Errorf("%w: wildcard %q must be the last segment of %q", ErrRouteConflict, s, pattern)
+		}
+	}
+	//检查冲突
+	now := r
+	for i := 0; i < len(ss) && now != nil; i++ {
+		s := ss[i]
+		switch s[0] {
+		case ':':
+			if now.wildcard != nil {
+				return fmt.Errorf("%w: parameter %q in %q conflicts with wildcard %q", ErrRouteConflict, s, pattern, "*"+now.wildcard.name)
+			}
+			if now.param != nil && now.param.name != s[1:] {
+				return fmt.Errorf("%w: parameter %q in %q conflicts with parameter %q", ErrRouteConflict, s, pattern, ":"+now.param.name)
+			}
+			now = now.param
+		case '*':
+			if now.param != nil {
+				return fmt.Errorf("%w: wildcard %q in %q conflicts with parameter %q", ErrRouteConflict, s, pattern, ":"+now.param.name)
+			}
+			if now.wildcard != nil && now.wildcard.name != s[1:] {
+				return fmt.Errorf("%w: wildcard %q in %q conflicts with wildcard %q", ErrRouteConflict, s, pattern, "*"+now.wildcard.name)
+			}
+			now = now.wildcard
+		default:
+			now = now.staticOf(s)
+		}
+	}
+	if now != nil && now.value != nil {
+		return fmt.Errorf("%w: %q is already registered as %q", ErrRouteConflict, pattern, now.pattern)
+	}
+	//插入路由
+	now = r
+	for _, s := range ss {
+		switch s[0] {
+		case ':':
+			if now.param == nil {
+				now.param = newRoute(s[1:])
+			}
+			now = now.param
+		case '*':
+			if now.wildcard == nil {
+				now.wildcard = newRoute(s[1:])
+			}
+			now = now.wildcard
+		default:
+			now = now.staticOrNew(s)
+		}
+	}
+	now.pattern, now.value = pattern, e
+	return nil
+}
+
+//@title    match
+//@description
+//		以route路由节点做接收者
+//		在以该节点为根的路由树中匹配分段后的路径ss
+//		依次尝试静态子节点、命名参数子节点和通配符子节点,失败时回退
+//		匹配成功时将捕获的参数写入params并返回终点节点
+//@receiver		r			*route					接受者route的指针
+//@param    	ss			[]string				剩余的路径分段
+//@param    	params		map[string]string		捕获的参数
+//@return    	m        	*route					匹配到的终点节点
+func (r *route) match(ss []string, params map[string]string) (m *route) {
+	if len(ss) == 0 {
+		if r.value != nil {
+			return r
+		}
+		if r.wildcard != nil && r.wildcard.value != nil {
+			params[r.wildcard.name] = ""
+			return r.wildcard
+		}
+		return nil
+	}
+	if c := r.staticOf(ss[0]); c != nil {
+		if m = c.match(ss[1:], params); m != nil {
+			return m
+		}
+	}
+	if r.param != nil {
+		if m = r.param.match(ss[1:], params); m != nil {
+			params[r.param.name] = ss[0]
+			return m
+		}
+	}
+	if r.wildcard != nil && r.wildcard.value != nil {
+		params[r.wildcard.name] = strings.Join(ss, "/")
+		return r.wildcard
+	}
+	return nil
+}
+
+//@title    InsertRoute
+//@description
+//		以radix基数树做接收者
+//		注册路由pattern并存放元素e,若e为nil则存放pattern本身
+//		pattern中以':'开头的段为命名参数,以'*'开头的段为通配符
+//		与已有路由冲突时返回以ErrRouteConflict为基础的错误,且不做任何修改
+//		路由与基数树中以Insert插入的key相互独立
+//@receiver		t			*radix					接受者radix的指针
+//@param    	pattern		string					路由
+//@param    	e			interface{}				路由对应的元素
+//@return    	err        	error					冲突时返回的错误
+func (t *radix) InsertRoute(pattern string, e interface{}) (err error) {
+	if t == nil {
+		return nil
+	}
+	if e == nil {
+		e = pattern
+	}
+	t.mutex.Lock()
+	if t.routes == nil {
+		t.routes = newRoute("")
+	}
+	err = t.routes.insert(pattern, splitPath(pattern), e)
+	if err == nil {
+		t.routeNum++
+	}
+	t.mutex.Unlock()
+	return err
+}
+
+//@title    Match
+//@description
+//		以radix基数树做接收者
+//		将路径path与已注册的路由进行匹配
+//		匹配成功时返回路由对应的元素以及捕获的参数,参数名不含':'和'*'
+//		匹配失败时ok返回false
+//@receiver		t			*radix					接受者radix的指针
+//@param    	path		string					待匹配的路径
+//@return    	e			interface{}				路由对应的元素
+//@return    	params		map[string]string		捕获的参数
+//@return    	ok			bool					匹配成功?
+func (t *radix) Match(path string) (e interface{}, params map[string]string, ok bool) {
+	if t == nil {
+		return nil, nil, false
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.routes == nil {
+		return nil, nil, false
+	}
+	params = make(map[string]string)
+	m := t.routes.match(splitPath(path), params)
+	if m == nil {
+		return nil, nil, false
+	}
+	return m.value, params, true
+}
+
+//@title    RouteCount
+//@description
+//		以radix基数树做接收者
+//		返回已注册的路由数量
+//		以Insert插入的key不计入其中
+//		如果容器为nil返回-1
+//@receiver		t			*radix					接受者radix的指针
+//@param    	nil
+//@return    	num        	int						已注册的路由数量
+func (t *radix) RouteCount() (num int) {
+	if t == nil {
+		return -1
+	}
+	t.mutex.Lock()
+	num = t.routeNum
+	t.mutex.Unlock()
+	return num
+}
+
+//@title    ClearRoutes
+//@description
+//		以radix基数树做接收者
+//		将已注册的路由清空
+//		以Insert插入的key不受影响
+//@receiver		t			*radix					接受者radix的指针
+//@param    	nil
+//@return    	nil
+func (t *radix) ClearRoutes() {
+	if t == nil {
+		return
+	}
+	t.mutex.Lock()
+	t.routes = nil
+	t.routeNum = 0
+	t.mutex.Unlock()
+}
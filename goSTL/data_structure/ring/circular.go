@@ -0,0 +1,390 @@
+package ring
+
+//@Title		ring
+//@Description
+//		circular定长环形缓冲区
+//		以固定长度的切片实现,容量在创建时确定且不再改变
+//		可在首尾两端以O(1)的时间增减元素,并以O(1)的时间随机访问
+//		缓冲区满时根据策略覆盖最早的元素或拒绝写入,适用于保存最近的日志或采样数据
+import (
+	"errors"
+	"github.com/hlccd/goSTL/utils/iterator"
+	"sync"
+)
+
+//Policy缓冲区满时的写入策略
+type Policy int
+
+const (
+	Overwrite Policy = iota //覆盖最早写入的元素
+	Reject                  //拒绝写入并返回ErrFull
+)
+
+//ErrFull缓冲区已满
+//在Reject策略下向已满的缓冲区写入时返回
+var ErrFull = errors.New("ring: circular buffer is full")
+
+//circular环形缓冲区结构体
+//包含定长泛型切片、首元素所在位置、元素数量以及写满时的策略
+//第i个元素存放于切片的(head+i)%len(data)位置
+type circular struct {
+	data   []interface{} //定长泛型切片
+	head   int           //首元素在切片中的位置
+	num    int           //元素数量
+	policy Policy        //缓冲区满时的写入策略
+	mutex  sync.Mutex    //并发控制锁
+}
+
+//circular环形缓冲区接口
+//存放了circular容器可使用的函数
+//对应函数介绍见下方
+type circularer interface {
+	Iterator() (i *iterator.Iterator)          //返回一个包含缓冲区中所有元素的迭代器
+	Size() (num int)                           //返回缓冲区中元素的数量
+	Cap() (num int)                            //返回缓冲区的容量
+	Clear()                                    //清空缓冲区
+	Empty() (b bool)                           //判断缓冲区是否为空
+	Full() (b bool)                            //判断缓冲区是否已满
+	Push(e interface{}) (err error)            //将元素e写入缓冲区尾部
+	Write(es []interface{}) (n int, err error) //将es中的元素依次写入缓冲区尾部
+	PopFront() (e interface{})                 //弹出缓冲区的首元素并返回
+	PopBack() (e interface{})                  //弹出缓冲区的尾元素并返回
+	Front() (e interface{})                    //返回缓冲区的首元素
+	Back() (e interface{})                     //返回缓冲区的尾元素
+	At(idx int) (e interface{})                //返回缓冲区第idx位的元素
+	Snapshot() (es []interface{})              //按序返回缓冲区中全部元素的副本
+}
+
+//@title    NewCircular
+//@description
+//		新建一个容量为capacity的circular环形缓冲区并返回
+//		capacity不大于0时容量设为1
+//		policy为缓冲区满时的写入策略,Overwrite为覆盖最早的元素,Reject为拒绝写入
+//@receiver		nil
+//@param    	capacity	int						缓冲区的容量
+//@param    	policy		Policy					缓冲区满时的写入策略
+//@return    	c        	*circular				新建的circular指针
+func NewCircular(capacity int, policy Policy) (c *circular) {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	return &circular{
+		data:   make([]interface{}, capacity, capacity),
+		head:   0,
+		num:    0,
+		policy: policy,
+		mutex:  sync.Mutex{},
+	}
+}
+
+//@title    pos
+//@description
+//		以circular环形缓冲区做接收者
+//		返回第idx个元素在切片中的实际位置
+//@receiver		c			*circular				接受者circular的指针
+//@param    	idx			int						元素的序号
+//@return    	p			int						元素在切片中的位置
+func (c *circular) pos(idx int) (p int) {
+	p = c.head + idx
+	if p >= len(c.data) {
+		p -= len(c.data)
+	}
+	return p
+}
+
+//@title    snapshot
+//@description
+//		以circular环形缓冲区做接收者
+//		将缓冲区中的元素按序复制到新切片中并返回
+//@receiver		c			*circular				接受者circular的指针
+//@param    	nil
+//@return    	es			[]interface{}			元素的副本
+func (c *circular) snapshot() (es []interface{}) {
+	es = make([]interface{}, c.num, c.num)
+	if c.head+c.num <= len(c.data) {
+		copy(es, c.data[c.head:c.head+c.num])
+	} else {
+		n := copy(es, c.data[c.head:])
+		copy(es[n:], c.data[:c.num-n])
+	}
+	return es
+}
+
+//@title    Iterator
+//@description
+//		以circular环形缓冲区做接收者
+//		返回一个由首至尾包含缓冲区中所有元素的迭代器
+//@receiver		c			*circular				接受者circular的指针
+//@param    	nil
+//@return    	i        	*iterator.Iterator		新建的Iterator迭代器指针
+func (c *circular) Iterator() (i *iterator.Iterator) {
+	if c == nil {
+		return iterator.New(make([]interface{}, 0, 0))
+	}
+	c.mutex.Lock()
+	i = iterator.New(c.snapshot())
+	c.mutex.Unlock()
+	return i
+}
+
+//@title    Size
+//@description
+//		以circular环形缓冲区做接收者
+//		返回该缓冲区当前含有元素的数量
+//		当缓冲区不存在时,返回-1
+//@receiver		c			*circular				接受者circular的指针
+//@param    	nil
+//@return    	num        	int						缓冲区中存储元素的个数
+func (c *circular) Size() (num int) {
+	if c == nil {
+		return -1
+	}
+	return c.num
+}
+
+//@title    Cap
+//@description
+//		以circular环形缓冲区做接收者
+//		返回该缓冲区的容量
+//		当缓冲区不存在时,返回0
+//@receiver		c			*circular				接受者circular的指针
+//@param    	nil
+//@return    	num        	int						缓冲区的容量
+func (c *circular) Cap() (num int) {
+	if c == nil {
+		return 0
+	}
+	return len(c.data)
+}
+
+//@title    Clear
+//@description
+//		以circular环形缓冲区做接收者
+//		将该缓冲区中所承载的元素清空,容量不变
+//@receiver		c			*circular				接受者circular的指针
+//@param    	nil
+//@return    	nil
+func (c *circular) Clear() {
+	if c == nil {
+		return
+	}
+	c.mutex.Lock()
+	for i := range c.data {
+		c.data[i] = nil
+	}
+	c.head = 0
+	c.num = 0
+	c.mutex.Unlock()
+}
+
+//@title    Empty
+//@description
+//		以circular环形缓冲区做接收者
+//		判断该缓冲区是否含有元素
+//		如果含有元素则不为空,返回false
+//		如果不含有元素则说明为空,返回true
+//		如果缓冲区不存在,返回true
+//@receiver		c			*circular				接受者circular的指针
+//@param    	nil
+//@return    	b			bool					该缓冲区是空的吗?
+func (c *circular) Empty() (b bool) {
+	if c == nil {
+		return true
+	}
+	return c.Size() <= 0
+}
+
+//@title    Full
+//@description
+//		以circular环形缓冲区做接收者
+//		判断该缓冲区是否已满
+//		如果缓冲区不存在,返回false
+//@receiver		c			*circular				接受者circular的指针
+//@param    	nil
+//@return    	b			bool					该缓冲区是满的吗?
+func (c *circular) Full() (b bool) {
+	if c == nil {
+		return false
+	}
+	return c.Size() >= c.Cap()
+}
+
+//@title    push
+//@description
+//		以circular环形缓冲区做接收者
+//		将元素e写入缓冲区尾部
+//		缓冲区已满时,Overwrite策略下覆盖首元素并使首元素位置后移,Reject策略下返回ErrFull
+//@receiver		c			*circular				接受者circular的指针
+//@param    	e			interface{}				待写入元素
+//@return    	err			error					缓冲区已满且拒绝写入时返回ErrFull
+func (c *circular) push(e interface{}) (err error) {
+	if c.num < len(c.data) {
+		c.data[c.pos(c.num)] = e
+		c.num++
+		return nil
+	}
+	if c.policy == Reject {
+		return ErrFull
+	}
+	c.data[c.head] = e
+	c.head = c.pos(1)
+	return nil
+}
+
+//@title    Push
+//@description
+//		以circular环形缓冲区做接收者
+//		将元素e写入缓冲区尾部,时间复杂度为O(1)
+//		缓冲区已满时,Overwrite策略下丢弃最早的元素,Reject策略下不写入并返回ErrFull
+//@receiver		c			*circular				接受者circular的指针
+//@param    	e			interface{}				待写入元素
+//@return    	err			error					缓冲区已满且拒绝写入时返回ErrFull
+func (c *circular) Push(e interface{}) (err error) {
+	if c == nil {
+		return nil
+	}
+	c.mutex.Lock()
+	err = c.push(e)
+	c.mutex.Unlock()
+	return err
+}
+
+//@title    Write
+//@description
+//		以circular环形缓冲区做接收者
+//		将es中的元素依次写入缓冲区尾部,只需加锁一次,返回成功写入的元素数量
+//		Overwrite策略下全部写入,若es长度超过容量则最终只保留其最后的部分
+//		Reject策略下写满即止,未能全部写入时返回ErrFull
+//@receiver		c			*circular				接受者circular的指针
+//@param    	es			[]interface{}			待写入元素
+//@return    	n			int						成功写入的元素数量
+//@return    	err			error					未能全部写入时返回ErrFull
+func (c *circular) Write(es []interface{}) (n int, err error) {
+	if c == nil {
+		return 0, nil
+	}
+	c.mutex.Lock()
+	for ; n < len(es); n++ {
+		if err = c.push(es[n]); err != nil {
+			break
+		}
+	}
+	c.mutex.Unlock()
+	return n, err
+}
+
+//@title    PopFront
+//@description
+//		以circular环形缓冲区做接收者
+//		弹出缓冲区的首元素并返回,即最早写入的元素
+//		若缓冲区为空,则返回nil
+//@receiver		c			*circular				接受者circular的指针
+//@param    	nil
+//@return    	e			interface{}				缓冲区的首元素
+func (c *circular) PopFront() (e interface{}) {
+	if c == nil {
+		return nil
+	}
+	c.mutex.Lock()
+	if c.num > 0 {
+		e = c.data[c.head]
+		c.data[c.head] = nil
+		c.head = c.pos(1)
+		c.num--
+	}
+	c.mutex.Unlock()
+	return e
+}
+
+//@title    PopBack
+//@description
+//		以circular环形缓冲区做接收者
+//		弹出缓冲区的尾元素并返回,即最近写入的元素
+//		若缓冲区为空,则返回nil
+//@receiver		c			*circular				接受者circular的指针
+//@param    	nil
+//@return    	e			interface{}				缓冲区的尾元素
+func (c *circular) PopBack() (e interface{}) {
+	if c == nil {
+		return nil
+	}
+	c.mutex.Lock()
+	if c.num > 0 {
+		p := c.pos(c.num - 1)
+		e = c.data[p]
+		c.data[p] = nil
+		c.num--
+	}
+	c.mutex.Unlock()
+	return e
+}
+
+//@title    Front
+//@description
+//		以circular环形缓冲区做接收者
+//		返回缓冲区的首元素
+//		若缓冲区为空,则返回nil
+//@receiver		c			*circular				接受者circular的指针
+//@param    	nil
+//@return    	e			interface{}				缓冲区的首元素
+func (c *circular) Front() (e interface{}) {
+	return c.At(0)
+}
+
+//@title    Back
+//@description
+//		以circular环形缓冲区做接收者
+//		返回缓冲区的尾元素
+//		若缓冲区为空,则返回nil
+//@receiver		c			*circular				接受者circular的指针
+//@param    	nil
+//@return    	e			interface{}				缓冲区的尾元素
+func (c *circular) Back() (e interface{}) {
+	if c == nil {
+		return nil
+	}
+	c.mutex.Lock()
+	if c.num > 0 {
+		e = c.data[c.pos(c.num-1)]
+	}
+	c.mutex.Unlock()
+	return e
+}
+
+//@title    At
+//@description
+//		以circular环形缓冲区做接收者
+//		返回缓冲区中第idx位的元素,首元素为第0位
+//		当idx小于0或者不小于元素数量时返回nil
+//		时间复杂度为O(1)
+//@receiver		c			*circular				接受者circular的指针
+//@param    	idx			int						待查找元素的位置
+//@return    	e			interface{}				第idx位的元素
+func (c *circular) At(idx int) (e interface{}) {
+	if c == nil {
+		return nil
+	}
+	c.mutex.Lock()
+	if idx >= 0 && idx < c.num {
+		e = c.data[c.pos(idx)]
+	}
+	c.mutex.Unlock()
+	return e
+}
+
+//@title    Snapshot
+//@description
+//		以circular环形缓冲区做接收者
+//		由首至尾返回缓冲区中全部元素的副本,不改变缓冲区
+//@receiver		c			*circular				接受者circular的指针
+//@param    	nil
+//@return    	es			[]interface{}			元素的副本
+func (c *circular) Snapshot() (es []interface{}) {
+	if c == nil {
+		return make([]interface{}, 0, 0)
+	}
+	c.mutex.Lock()
+	es = c.snapshot()
+	c.mutex.Unlock()
+	return es
+}
@@ -0,0 +1,133 @@
+package ring
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+//push按策略向参照模型写入e,返回是否写入
+func push(m []interface{}, capacity int, policy Policy, e interface{}) ([]interface{}, bool) {
+	if len(m) < capacity {
+		return append(m, e), true
+	}
+	if policy == Reject {
+		return m, false
+	}
+	return append(m[1:], e), true
+}
+
+func TestCircularModel(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, policy := range []Policy{Overwrite, Reject} {
+		for _, capacity := range []int{1, 2, 3, 8, 13} {
+			c := NewCircular(capacity, policy)
+			m := make([]interface{}, 0, 0)
+			id := 0
+			for it := 0; it < 5000; it++ {
+				id++
+				switch r.Intn(6) {
+				case 0, 1:
+					err := c.Push(id)
+					var ok bool
+					m, ok = push(m, capacity, policy, id)
+					if ok != (err == nil) || !ok && err != ErrFull {
+						t.Fatalf("policy %d cap %d: Push = %v", policy, capacity, err)
+					}
+				case 2:
+					es := make([]interface{}, r.Intn(2*capacity+2))
+					for i := range es {
+						id++
+						es[i] = id
+					}
+					n, err := c.Write(es)
+					want := 0
+					for _, e := range es {
+						var ok bool
+						if m, ok = push(m, capacity, policy, e); !ok {
+							break
+						}
+						want++
+					}
+					if n != want || (want < len(es)) != (err == ErrFull) {
+						t.Fatalf("policy %d cap %d: Write = %d, %v, want %d", policy, capacity, n, err, want)
+					}
+				case 3:
+					e := c.PopFront()
+					if len(m) == 0 {
+						if e != nil {
+							t.Fatalf("PopFront on empty buffer = %v", e)
+						}
+						break
+					}
+					if e != m[0] {
+						t.Fatalf("PopFront = %v, want %v", e, m[0])
+					}
+					m = m[1:]
+				case 4:
+					e := c.PopBack()
+					if len(m) == 0 {
+						if e != nil {
+							t.Fatalf("PopBack on empty buffer = %v", e)
+						}
+						break
+					}
+					if e != m[len(m)-1] {
+						t.Fatalf("PopBack = %v, want %v", e, m[len(m)-1])
+					}
+					m = m[:len(m)-1]
+				case 5:
+					if r.Intn(20) == 0 {
+						c.Clear()
+						m = m[:0]
+					}
+				}
+				if c.Size() != len(m) || c.Cap() != capacity || c.Full() != (len(m) == capacity) || c.Empty() != (len(m) == 0) {
+					t.Fatalf("policy %d cap %d: Size = %d, want %d", policy, capacity, c.Size(), len(m))
+				}
+				if got := c.Snapshot(); !reflect.DeepEqual(got, append(make([]interface{}, 0, len(m)), m...)) {
+					t.Fatalf("policy %d cap %d: Snapshot = %v, want %v", policy, capacity, got, m)
+				}
+				if len(m) > 0 && (c.Front() != m[0] || c.Back() != m[len(m)-1]) {
+					t.Fatalf("Front/Back = %v/%v, want %v/%v", c.Front(), c.Back(), m[0], m[len(m)-1])
+				}
+				if i := r.Intn(capacity+2) - 1; (c.At(i) != nil) != (i >= 0 && i < len(m)) {
+					t.Fatalf("At(%d) = %v with %d elements", i, c.At(i), len(m))
+				}
+			}
+		}
+	}
+}
+
+func TestOverwriteReject(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy Policy
+		n      int
+		err    error
+		want   []interface{}
+	}{
+		{"overwrite keeps the newest", Overwrite, 5, nil, []interface{}{3, 4, 5}},
+		{"reject keeps the oldest", Reject, 3, ErrFull, []interface{}{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCircular(3, tt.policy)
+			n, err := c.Write([]interface{}{1, 2, 3, 4, 5})
+			if n != tt.n || err != tt.err {
+				t.Fatalf("Write = %d, %v, want %d, %v", n, err, tt.n, tt.err)
+			}
+			if got := c.Snapshot(); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Snapshot = %v, want %v", got, tt.want)
+			}
+			//弹出一个后可再写入一个
+			c.PopFront()
+			if err := c.Push(6); err != nil {
+				t.Fatalf("Push after PopFront = %v", err)
+			}
+			if c.Back() != 6 || !c.Full() {
+				t.Fatalf("Back = %v, Full = %v", c.Back(), c.Full())
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package ring
+
+//@Title		ring
+//@Description
+//		环的节点
+//		节点之间以前后指针相连形成环形双向链表
+//		可通过节点在O(1)时间内于其后插入或拼接节点,以及删除其后的若干节点
+
+//node环节点结构体
+//pre和next分别指向前后节点,环中仅有一个节点时均指向其自身
+type node struct {
+	value interface{} //节点中存储的元素
+	pre   *node       //前一个节点
+	next  *node       //后一个节点
+}
+
+//@title    newNode
+//@description
+//		新建一个环节点并返回
+//		将传入的元素e作为该节点的承载元素
+//		该节点的前后节点均指向其自身,即自成一个环
+//@receiver		nil
+//@param    	e			interface{}				承载元素e
+//@return    	n        	*node					新建的环节点的指针
+func newNode(e interface{}) (n *node) {
+	n = &node{
+		value: e,
+	}
+	n.pre, n.next = n, n
+	return n
+}
+
+//@title    move
+//@description
+//		以node环节点做接收者
+//		返回由该节点出发移动k步后的节点,k为负数时向前移动
+//@receiver		n			*node					接受者node的指针
+//@param    	k			int						移动的步数
+//@return    	m        	*node					移动后的节点
+func (n *node) move(k int) (m *node) {
+	m = n
+	for ; k > 0; k-- {
+		m = m.next
+	}
+	for ; k < 0; k++ {
+		m = m.pre
+	}
+	return m
+}
+
+//@title    link
+//@description
+//		以node环节点做接收者
+//		将以s为起点的另一个环整体拼接到该节点之后
+//		拼接后s紧随该节点之后,s原本的前一个节点紧邻该节点原本的后一个节点之前
+//@receiver		n			*node					接受者node的指针
+//@param    	s			*node					另一个环的起点
+//@return    	nil
+func (n *node) link(s *node) {
+	t := s.pre
+	n.next.pre, t.next = t, n.next
+	n.next, s.pre = s, n
+}
+
+//@title    unlink
+//@description
+//		以node环节点做接收者
+//		将该节点之后的k个节点从环中取出,取出的节点自成一个环并返回其起点
+//		k必须大于0且小于环中的节点数量
+//@receiver		n			*node					接受者node的指针
+//@param    	k			int						取出的节点数量
+//@return    	s        	*node					取出的节点所成环的起点
+func (n *node) unlink(k int) (s *node) {
+	s = n.next
+	t := n.move(k)
+	n.next, t.next.pre = t.next, n
+	t.next, s.pre = s, t
+	return s
+}
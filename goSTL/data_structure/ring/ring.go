@@ -3,8 +3,9 @@ package ring
 //@Title		ring
 //@Description
 //		ring环容器包
-//		以切片数组的形式实现
-//		该容器可以在当前节点实现线性增减元素
+//		以环形双向链表的形式实现
+//		该容器可以在当前节点处以O(1)的时间增减元素
+//		支持当前节点的任意移动、环的拼接与拆分以及遍历
 //		可接纳不同类型的元素
 //@author     	hlccd		2021-07-8
 //@update		hlccd 		2021-08-01		增加互斥锁实现并发控制
@@ -14,12 +15,12 @@ import (
 )
 
 //ring环结构体
-//包含泛型切片和该切片的当前位置指针
-//增删节点都会重新规划空间
+//包含当前节点指针和元素数量
+//增删节点只需修改相邻节点的指针
 type ring struct {
-	data  []interface{} //泛型切片
-	index int           //当前节点指针
-	mutex sync.Mutex    //并发控制锁
+	now   *node      //当前节点指针
+	num   int        //存储元素数量
+	mutex sync.Mutex //并发控制锁
 }
 
 //ring环容器接口
@@ -28,28 +29,33 @@ type ring struct {
 type ringer interface {
 	Iterator() (i *iterator.Iterator) //返回一个包含ring容器中所有使用元素的迭代器
 	Size() (num int)                  //返回该ring容器中所含有的元素个数
+	Len() (num int)                   //返回该ring容器中所含有的元素个数,容器不存在时为0
 	Clear()                           //清空该ring容器
 	Empty() (b bool)                  //判断该ring容器是否为空
 	Insert(e interface{})             //在当前节点元素后面添加一个元素
 	Erase()                           //删除该节点元素
 	Next()                            //将该ring容器节点后移
 	Pre()                             //将该ring容器节点前移
+	Move(n int)                       //将该ring容器节点移动n位
 	Value() (e interface{})           //返回该ring容器当前节点元素
+	Link(other *ring)                 //将另一个ring容器拼接到当前节点之后
+	Unlink(n int) (r2 *ring)          //将当前节点之后的n个元素取出为新的ring容器
+	Do(fn func(e interface{}))        //由当前节点起依次对每个元素调用fn
 }
 
 //@title    New
 //@description
 //		新建一个ring环容器并返回
-//		初始ring的切片数组为空
-//		初始ring的节点指针置零
+//		初始ring的当前节点为nil
+//		初始ring的元素数量置零
 //@author     	hlccd		2021-07-8
 //@receiver		nil
 //@param    	nil
 //@return    	r        	*ring					新建的ring指针
 func New() (r *ring) {
 	return &ring{
-		data:  make([]interface{}, 0, 0),
-		index: 0,
+		now:   nil,
+		num:   0,
 		mutex: sync.Mutex{},
 	}
 }
@@ -67,11 +73,14 @@ func (r *ring) Iterator() (i *iterator.Iterator) {
 	if r == nil {
 		return iterator.New(make([]interface{}, 0, 0))
 	}
-	if r.Empty() {
-		return iterator.New(make([]interface{}, 0, 0))
-	}
 	r.mutex.Lock()
-	i = iterator.New(append(r.data[r.index:], r.data[:r.index]...))
+	es := make([]interface{}, 0, r.num)
+	p := r.now
+	for k := 0; k < r.num; k++ {
+		es = append(es, p.value)
+		p = p.next
+	}
+	i = iterator.New(es)
 	r.mutex.Unlock()
 	return i
 }
@@ -89,14 +98,29 @@ func (r *ring) Size() (num int) {
 	if r == nil {
 		return -1
 	}
-	return len(r.data)
+	return r.num
+}
+
+//@title    Len
+//@description
+//		以ring环容器做接收者
+//		返回该容器当前含有元素的数量,时间复杂度为O(1)
+//		与Size不同,当容器不存在时返回0
+//@receiver		r			*ring					接受者ring的指针
+//@param    	nil
+//@return    	num        	int						容器中存储元素的个数
+func (r *ring) Len() (num int) {
+	if r == nil {
+		return 0
+	}
+	return r.num
 }
 
 //@title    Clear
 //@description
 //		以ring环容器做接收者
 //		将该容器中所承载的元素清空
-//		将该容器的节点指针置0
+//		将该容器的当前节点置nil
 //@auth      	hlccd		2021-07-8
 //@receiver		r			*ring					接受者ring的指针
 //@param    	nil
@@ -106,8 +130,8 @@ func (r *ring) Clear() {
 		return
 	}
 	r.mutex.Lock()
-	r.data = r.data[0:0]
-	r.index = -1
+	r.now = nil
+	r.num = 0
 	r.mutex.Unlock()
 }
 
@@ -133,7 +157,9 @@ func (r *ring) Empty() (b bool) {
 //@title    Insert
 //@description
 //		以ring环容器做接收者
-//		在容器当前节点下一位置插入元素,并将原节点以后元素后移一位
+//		在容器当前节点下一位置插入元素,当前节点不变
+//		若容器为空,则插入的元素即为当前节点
+//		时间复杂度为O(1)
 //@auth      	hlccd		2021-07-8
 //@receiver		r			*ring					接受者ring的指针
 //@param    	e			interface{}				待插入元素
@@ -143,12 +169,13 @@ func (r *ring) Insert(e interface{}) {
 		return
 	}
 	r.mutex.Lock()
-	if r.index < r.Size()-1 {
-		es := append([]interface{}{}, r.data[r.index+1:]...)
-		r.data = append(append(r.data[:r.index+1], e), es...)
+	n := newNode(e)
+	if r.now == nil {
+		r.now = n
 	} else {
-		r.data = append(r.data, e)
+		r.now.link(n)
 	}
+	r.num++
 	r.mutex.Unlock()
 }
 
@@ -156,7 +183,8 @@ func (r *ring) Insert(e interface{}) {
 //@description
 //		以ring环容器做接收者
 //		如果元素集合为空则直接结束
-//		否则删除当前节点元素并将该节点后面元素前移一位,该节点指向原节点的下一位
+//		否则删除当前节点元素,该节点指向原节点的下一位
+//		时间复杂度为O(1)
 //@auth      	hlccd		2021-07-8
 //@receiver		r			*ring					接受者ring的指针
 //@param    	nil
@@ -165,18 +193,15 @@ func (r *ring) Erase() {
 	if r == nil {
 		return
 	}
-	if r.Empty() {
-		return
-	}
 	r.mutex.Lock()
-	if r.index == 0 {
-		r.data = r.data[1:]
-	} else if r.index == r.Size()-1 {
-		r.data = r.data[:r.Size()-1]
-		r.index = 0
-	} else {
-		es := append([]interface{}{}, r.data[:r.index]...)
-		r.data = append(es, r.data[r.index+1:]...)
+	if r.num == 1 {
+		r.now = nil
+		r.num = 0
+	} else if r.num > 1 {
+		p := r.now.pre
+		p.unlink(1)
+		r.now = p.next
+		r.num--
 	}
 	r.mutex.Unlock()
 }
@@ -185,7 +210,7 @@ func (r *ring) Erase() {
 //@description
 //		以ring环容器做接收者
 //		如果元素集合为空则直接结束
-//		否则将该节点前移,令原节点位置指向下一位元素
+//		否则将该节点后移,令原节点位置指向下一位元素
 //@auth      	hlccd		2021-07-8
 //@receiver		r			*ring					接受者ring的指针
 //@param    	nil
@@ -194,11 +219,10 @@ func (r *ring) Next() {
 	if r == nil {
 		return
 	}
-	if r.Empty() {
-		return
-	}
 	r.mutex.Lock()
-	r.index = (r.index + 1) % r.Size()
+	if r.now != nil {
+		r.now = r.now.next
+	}
 	r.mutex.Unlock()
 }
 
@@ -206,7 +230,7 @@ func (r *ring) Next() {
 //@description
 //		以ring环容器做接收者
 //		如果元素集合为空则直接结束
-//		否则将该节点后移,令原节点位置指向前一位元素
+//		否则将该节点前移,令原节点位置指向前一位元素
 //@auth      	hlccd		2021-07-8
 //@receiver		r			*ring					接受者ring的指针
 //@param    	nil
@@ -215,11 +239,37 @@ func (r *ring) Pre() {
 	if r == nil {
 		return
 	}
-	if r.Empty() {
+	r.mutex.Lock()
+	if r.now != nil {
+		r.now = r.now.pre
+	}
+	r.mutex.Unlock()
+}
+
+//@title    Move
+//@description
+//		以ring环容器做接收者
+//		将当前节点后移n位,n为负数时前移-n位
+//		移动步数先对元素数量取模,并选择较短的方向移动
+//		如果元素集合为空则直接结束
+//@receiver		r			*ring					接受者ring的指针
+//@param    	n			int						移动的位数
+//@return    	nil
+func (r *ring) Move(n int) {
+	if r == nil {
 		return
 	}
 	r.mutex.Lock()
-	r.index = (r.index - 1 + r.Size()) % r.Size()
+	if r.num > 0 {
+		n %= r.num
+		if n < 0 {
+			n += r.num
+		}
+		if n > r.num/2 {
+			n -= r.num
+		}
+		r.now = r.now.move(n)
+	}
 	r.mutex.Unlock()
 }
 
@@ -230,18 +280,98 @@ func (r *ring) Pre() {
 //		若该容器当前为空,则返回nil
 //		若容器为nil则返回nil
 //@auth      	hlccd		2021-07-5
-//@receiver		q			*queue					接受者queue的指针
+//@receiver		r			*ring					接受者ring的指针
 //@param    	nil
-//@return    	e			interface{}				容器的第一个元素
+//@return    	e			interface{}				当前节点的元素
 func (r *ring) Value() (e interface{}) {
 	if r == nil {
 		return nil
 	}
-	if r.Empty() {
-		return nil
-	}
 	r.mutex.Lock()
-	e = r.data[r.index]
+	if r.now != nil {
+		e = r.now.value
+	}
 	r.mutex.Unlock()
 	return e
 }
+
+//@title    Link
+//@description
+//		以ring环容器做接收者
+//		将另一个ring容器的全部元素拼接到当前节点之后,拼接后另一个ring容器为空
+//		拼接后的顺序为:当前节点、另一个ring容器由其当前节点起的全部元素、当前节点原本的后续节点
+//		若该容器为空,则另一个ring容器的当前节点成为该容器的当前节点
+//		只需修改两处链接,时间复杂度为O(1)
+//		若两者为同一个ring容器则不做拼接
+//@receiver		r			*ring					接受者ring的指针
+//@param    	other		*ring					待拼接的ring容器
+//@return    	nil
+func (r *ring) Link(other *ring) {
+	if r == nil || other == nil || r == other {
+		return
+	}
+	other.mutex.Lock()
+	s, num := other.now, other.num
+	other.now, other.num = nil, 0
+	other.mutex.Unlock()
+	if s == nil {
+		return
+	}
+	r.mutex.Lock()
+	if r.now == nil {
+		r.now = s
+	} else {
+		r.now.link(s)
+	}
+	r.num += num
+	r.mutex.Unlock()
+}
+
+//@title    Unlink
+//@description
+//		以ring环容器做接收者
+//		将当前节点之后的n个元素从该容器中取出,并作为新的ring容器返回
+//		新容器的当前节点为取出的第一个元素,当前节点本身不会被取出
+//		若n大于当前节点之后的元素数量,则取出当前节点之外的全部元素
+//		若n不大于0或该容器为空,则返回一个空的ring容器
+//@receiver		r			*ring					接受者ring的指针
+//@param    	n			int						取出的元素数量
+//@return    	r2			*ring					由取出的元素组成的ring容器
+func (r *ring) Unlink(n int) (r2 *ring) {
+	r2 = New()
+	if r == nil {
+		return r2
+	}
+	r.mutex.Lock()
+	if n > r.num-1 {
+		n = r.num - 1
+	}
+	if n > 0 {
+		r2.now = r.now.unlink(n)
+		r2.num = n
+		r.num -= n
+	}
+	r.mutex.Unlock()
+	return r2
+}
+
+//@title    Do
+//@description
+//		以ring环容器做接收者
+//		由当前节点起按后移方向依次对每个元素调用fn
+//		遍历过程中持有该容器的锁,fn中不可再调用该容器的方法
+//@receiver		r			*ring					接受者ring的指针
+//@param    	fn			func(e interface{})		对每个元素调用的函数
+//@return    	nil
+func (r *ring) Do(fn func(e interface{})) {
+	if r == nil || fn == nil {
+		return
+	}
+	r.mutex.Lock()
+	p := r.now
+	for k := 0; k < r.num; k++ {
+		fn(p.value)
+		p = p.next
+	}
+	r.mutex.Unlock()
+}
@@ -0,0 +1,161 @@
+package ring
+
+import (
+	"math/rand"
+	"testing"
+)
+
+//参照模型为切片,下标0即为当前节点
+//rotate将当前节点后移k位
+func rotate(m []interface{}, k int) []interface{} {
+	if len(m) == 0 {
+		return m
+	}
+	k = (k%len(m) + len(m)) % len(m)
+	return append(append(make([]interface{}, 0, len(m)), m[k:]...), m[:k]...)
+}
+
+//insertNext在当前节点之后插入es,模型为空时es的首个元素成为当前节点
+func insertNext(m []interface{}, es ...interface{}) []interface{} {
+	if len(m) == 0 {
+		return append(make([]interface{}, 0, len(es)), es...)
+	}
+	res := make([]interface{}, 0, len(m)+len(es))
+	res = append(append(append(res, m[0]), es...), m[1:]...)
+	return res
+}
+
+//checkModel检查环与模型一致,且每个节点的前后指针相互对应
+func checkModel(t *testing.T, r *ring, m []interface{}) {
+	t.Helper()
+	if r.Len() != len(m) || r.Size() != len(m) {
+		t.Fatalf("Len = %d, want %d", r.Len(), len(m))
+	}
+	i := 0
+	r.Do(func(e interface{}) {
+		if e != m[i] {
+			t.Fatalf("Do[%d] = %v, want %v", i, e, m[i])
+		}
+		i++
+	})
+	i = 0
+	for it := r.Iterator(); it.HasNext(); it.Next() {
+		if it.Value() != m[i] {
+			t.Fatalf("Iterator[%d] = %v, want %v", i, it.Value(), m[i])
+		}
+		i++
+	}
+	if i != len(m) {
+		t.Fatalf("Iterator returned %d elements, want %d", i, len(m))
+	}
+	if len(m) == 0 {
+		if r.Value() != nil {
+			t.Fatalf("empty ring has Value %v", r.Value())
+		}
+		return
+	}
+	p := r.now
+	for k := 0; k < len(m); k++ {
+		if p.next.pre != p {
+			t.Fatalf("node %d: next.pre does not point back", k)
+		}
+		p = p.next
+	}
+	if p != r.now || r.Value() != m[0] {
+		t.Fatal("ring is not closed after Len steps")
+	}
+}
+
+func TestRingModel(t *testing.T) {
+	rd := rand.New(rand.NewSource(1))
+	r := New()
+	m := make([]interface{}, 0, 0)
+	id := 0
+	for it := 0; it < 50000; it++ {
+		id++
+		switch rd.Intn(9) {
+		case 0, 1:
+			r.Insert(id)
+			m = insertNext(m, id)
+		case 2:
+			r.Erase()
+			if len(m) > 0 {
+				m = m[1:]
+			}
+		case 3:
+			r.Next()
+			m = rotate(m, 1)
+		case 4:
+			r.Pre()
+			m = rotate(m, -1)
+		case 5:
+			k := rd.Intn(41) - 20
+			r.Move(k)
+			m = rotate(m, k)
+		case 6:
+			//拼接的环由其当前节点起依次接在当前节点之后
+			o := New()
+			om := make([]interface{}, 0, 0)
+			for k := rd.Intn(4); k > 0; k-- {
+				id++
+				o.Insert(id)
+				om = insertNext(om, id)
+			}
+			k := rd.Intn(5)
+			o.Move(k)
+			om = rotate(om, k)
+			r.Link(o)
+			if !o.Empty() {
+				t.Fatalf("Link left %d elements in the other ring", o.Len())
+			}
+			m = insertNext(m, om...)
+		case 7:
+			//取出当前节点之后的n个元素,n超出时取出除当前节点外的全部元素
+			k := rd.Intn(6) - 1
+			r2 := r.Unlink(k)
+			n := k
+			if n > len(m)-1 {
+				n = len(m) - 1
+			}
+			if n <= 0 {
+				checkModel(t, r2, nil)
+				break
+			}
+			checkModel(t, r2, append(make([]interface{}, 0, n), m[1:1+n]...))
+			m = append(m[:1:1], m[1+n:]...)
+		case 8:
+			if rd.Intn(50) == 0 {
+				r.Clear()
+				m = m[:0:0]
+			}
+		}
+		checkModel(t, r, m)
+	}
+}
+
+func TestLinkUnlink(t *testing.T) {
+	r := New()
+	for _, e := range []interface{}{1, 4, 3, 2} {
+		r.Insert(e)
+	}
+	//Insert插入在当前节点之后,故环为1 2 3 4
+	checkModel(t, r, []interface{}{1, 2, 3, 4})
+	o := New()
+	o.Insert(5)
+	o.Insert(6)
+	r.Link(o)
+	checkModel(t, r, []interface{}{1, 5, 6, 2, 3, 4})
+	checkModel(t, o, nil)
+	r.Link(r)
+	checkModel(t, r, []interface{}{1, 5, 6, 2, 3, 4})
+	r2 := r.Unlink(2)
+	checkModel(t, r2, []interface{}{5, 6})
+	checkModel(t, r, []interface{}{1, 2, 3, 4})
+	//取出的环可再次拼接回原处
+	r.Link(r2)
+	checkModel(t, r, []interface{}{1, 5, 6, 2, 3, 4})
+	r2 = r.Unlink(100)
+	checkModel(t, r2, []interface{}{5, 6, 2, 3, 4})
+	checkModel(t, r, []interface{}{1})
+	checkModel(t, r.Unlink(1), nil)
+}
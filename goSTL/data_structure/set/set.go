@@ -159,23 +159,17 @@ func (s *set) Insert(e interface{}) {
 		s.mutex.Unlock()
 		return
 	}
-	i:=iterator.New(s.data)
-	begin := i.Begin()
-	end := i.End()
-	p := algorithm.LowerBound(begin, end, e, s.cmp)
-	if s.data[p] == e {
+	i := iterator.New(s.data)
+	lo, hi := algorithm.EqualRange(i.Begin(), i.End(), e, s.cmp)
+	if hi > lo {
+		//该元素已存在
 		s.mutex.Unlock()
 		return
 	}
-	if p == len(s.data)-1 {
-		s.data = append(s.data, e)
-	} else if p == 0 {
-		es := append([]interface{}{}, e)
-		s.data = append(es, s.data...)
-	} else {
-		es := append([]interface{}{}, s.data[p:]...)
-		s.data = append(append(s.data[:p], e), es...)
-	}
+	//在首个大于该元素的位置插入
+	s.data = append(s.data, nil)
+	copy(s.data[lo+1:], s.data[lo:])
+	s.data[lo] = e
 	s.mutex.Unlock()
 }
 
@@ -192,11 +186,11 @@ func (s *set) Erase(e interface{}) {
 		return
 	}
 	s.mutex.Lock()
-	i:=iterator.New(s.data)
+	i := iterator.New(s.data)
 	p := algorithm.Search(i.Begin(), i.End(), e, s.cmp)
 	if p != -1 {
 		if len(s.data) == 1 {
-			s.Clear()
+			s.data = s.data[0:0]
 		} else {
 			if p == 0 {
 				s.data = s.data[1:]
@@ -222,7 +216,8 @@ func (s *set) Count(e interface{}) (num int) {
 		return 0
 	}
 	s.mutex.Lock()
-	p := algorithm.Search(s.Iterator().Begin(), s.Iterator().End(), e, s.cmp)
+	i := iterator.New(s.data)
+	p := algorithm.Search(i.Begin(), i.End(), e, s.cmp)
 	if p != -1 {
 		s.mutex.Unlock()
 		return 1
@@ -245,10 +240,11 @@ func (s *set) Find(e interface{}) (i *iterator.Iterator) {
 		return nil
 	}
 	s.mutex.Lock()
-	p := algorithm.Search(s.Iterator().Begin(), s.Iterator().End(), e, s.cmp)
+	i = iterator.New(s.data)
+	p := algorithm.Search(i.Begin(), i.End(), e, s.cmp)
 	if p != -1 {
 		s.mutex.Unlock()
-		return s.Iterator().Get(p)
+		return i.Get(p)
 	}
 	s.mutex.Unlock()
 	return nil
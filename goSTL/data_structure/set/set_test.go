@@ -0,0 +1,68 @@
+package set
+
+import "testing"
+
+type record struct {
+	key int
+	tag string
+}
+
+func recordCmp(a, b interface{}) int {
+	x, y := a.(record).key, b.(record).key
+	if x < y {
+		return -1
+	}
+	if x > y {
+		return 1
+	}
+	return 0
+}
+
+func TestCountFind(t *testing.T) {
+	s := New(recordCmp)
+	//乱序插入,key相等的元素只保留首个插入的
+	for _, r := range []record{{7, "f"}, {3, "b"}, {9, "h"}, {3, "c"}, {1, "a"}, {5, "e"}, {7, "g"}} {
+		s.Insert(r)
+	}
+	tests := []struct {
+		name  string
+		key   int
+		count int
+		tag   string
+	}{
+		{"absent before first", 0, 0, ""},
+		{"first", 1, 1, "a"},
+		{"duplicate insert", 3, 1, "b"},
+		{"absent inside", 4, 0, ""},
+		{"single in middle", 5, 1, "e"},
+		{"duplicate insert before last", 7, 1, "f"},
+		{"last", 9, 1, "h"},
+		{"absent after last", 10, 0, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := record{key: tt.key}
+			if got := s.Count(e); got != tt.count {
+				t.Errorf("Count = %d, want %d", got, tt.count)
+			}
+			i := s.Find(e)
+			if tt.count == 0 {
+				if i != nil {
+					t.Errorf("Find = %v, want nil", i.Value())
+				}
+				return
+			}
+			if i == nil || i.Value().(record).tag != tt.tag {
+				t.Errorf("Find did not return %d tagged %q", tt.key, tt.tag)
+			}
+		})
+	}
+	want := "abefh"
+	got := ""
+	for i := s.Iterator(); i.HasNext(); i.Next() {
+		got += i.Value().(record).tag
+	}
+	if got != want {
+		t.Errorf("order = %s, want %s", got, want)
+	}
+}
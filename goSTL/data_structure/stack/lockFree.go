@@ -0,0 +1,160 @@
+package stack
+
+//@Title		stack
+//@Description
+//		lockFree无锁栈
+//		以Treiber算法实现的多生产者多消费者栈
+//		以单向链表存储元素,栈顶指针通过CAS原子操作修改而不使用互斥锁
+//		在大量协程并发压入和弹出时可避免锁竞争
+//		该容器满足LIFO的后进先出模式
+//		可接纳不同类型的元素
+import (
+	"sync/atomic"
+	"unsafe"
+)
+
+//lockFree无锁栈结构体
+//top指向栈顶节点,栈为空时为nil
+//num为元素数量,仅在操作完成后原子增减,并发时为近似值
+type lockFree struct {
+	top unsafe.Pointer //栈顶节点指针,类型为*lockFreeNode
+	num int64          //元素数量
+}
+
+//lockFreeNode无锁栈节点结构体
+//节点在压入栈之前设置next,压入后不再修改
+type lockFreeNode struct {
+	value interface{}   //节点中存储的元素
+	next  *lockFreeNode //下一个节点
+}
+
+//lockFree无锁栈接口
+//存放了lockFree容器可使用的函数
+//对应函数介绍见下方
+type lockFreeer interface {
+	Size() (num int)      //返回该栈中元素的数量
+	Empty() (b bool)      //判断该栈是否为空
+	Push(e interface{})   //将元素e压入栈顶
+	Pop() (e interface{}) //弹出栈顶元素并返回
+	Top() (e interface{}) //返回栈顶元素
+}
+
+//@title    NewLockFree
+//@description
+//		新建一个lockFree无锁栈容器并返回
+//		初始栈顶为nil
+//@receiver		nil
+//@param    	nil
+//@return    	s        	*lockFree				新建的lockFree指针
+func NewLockFree() (s *lockFree) {
+	return &lockFree{
+		top: nil,
+		num: 0,
+	}
+}
+
+//@title    Size
+//@description
+//		以lockFree无锁栈做接收者
+//		返回该栈当前含有元素的数量
+//		并发压入和弹出时该值仅为近似值
+//		由于元素数量在链接节点之后才增加,并发时可能短暂为负,此时返回0
+//		当容器为nil时返回-1
+//@receiver		s			*lockFree				接受者lockFree的指针
+//@param    	nil
+//@return    	num        	int						容器中存储元素的个数
+func (s *lockFree) Size() (num int) {
+	if s == nil {
+		return -1
+	}
+	num = int(atomic.LoadInt64(&s.num))
+	if num < 0 {
+		return 0
+	}
+	return num
+}
+
+//@title    Empty
+//@description
+//		以lockFree无锁栈做接收者
+//		判断该栈是否含有元素
+//		通过栈顶是否为nil进行判断
+//		如果容器不存在,返回true
+//@receiver		s			*lockFree				接受者lockFree的指针
+//@param    	nil
+//@return    	b			bool					该容器是空的吗?
+func (s *lockFree) Empty() (b bool) {
+	if s == nil {
+		return true
+	}
+	return atomic.LoadPointer(&s.top) == nil
+}
+
+//@title    Push
+//@description
+//		以lockFree无锁栈做接收者
+//		将元素e压入栈顶
+//		令新节点指向当前栈顶后通过CAS将栈顶修改为新节点,失败则重试
+//@receiver		s			*lockFree				接受者lockFree的指针
+//@param    	e			interface{}				待压入元素
+//@return    	nil
+func (s *lockFree) Push(e interface{}) {
+	if s == nil {
+		return
+	}
+	n := &lockFreeNode{value: e}
+	for {
+		top := atomic.LoadPointer(&s.top)
+		n.next = (*lockFreeNode)(top)
+		if atomic.CompareAndSwapPointer(&s.top, top, unsafe.Pointer(n)) {
+			break
+		}
+	}
+	atomic.AddInt64(&s.num, 1)
+}
+
+//@title    Pop
+//@description
+//		以lockFree无锁栈做接收者
+//		弹出栈顶元素并返回
+//		与stack不同,由于并发时先Top再Pop无法保证取得的是被弹出的元素,故Pop直接返回被弹出的元素
+//		通过CAS将栈顶修改为其下一个节点,失败则重试
+//		若该栈为空,则返回nil
+//@receiver		s			*lockFree				接受者lockFree的指针
+//@param    	nil
+//@return    	e			interface{}				栈顶元素
+func (s *lockFree) Pop() (e interface{}) {
+	if s == nil {
+		return nil
+	}
+	for {
+		top := atomic.LoadPointer(&s.top)
+		if top == nil {
+			return nil
+		}
+		n := (*lockFreeNode)(top)
+		if atomic.CompareAndSwapPointer(&s.top, top, unsafe.Pointer(n.next)) {
+			atomic.AddInt64(&s.num, -1)
+			return n.value
+		}
+	}
+}
+
+//@title    Top
+//@description
+//		以lockFree无锁栈做接收者
+//		返回栈顶元素但不弹出
+//		若该栈为空,则返回nil
+//@receiver		s			*lockFree				接受者lockFree的指针
+//@param    	nil
+//@return    	e			interface{}				栈顶元素
+func (s *lockFree) Top() (e interface{}) {
+	if s == nil {
+		return nil
+	}
+	top := (*lockFreeNode)(atomic.LoadPointer(&s.top))
+	if top == nil {
+		return nil
+	}
+	return top.value
+}
@@ -0,0 +1,117 @@
+package stack
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestLockFreeMPMC(t *testing.T) {
+	const producers, consumers, per = 4, 4, 5000
+	s := NewLockFree()
+	//并发压入全部完成后再并发弹出,此时每个消费者取得的同一生产者的元素必为逆序
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < per; i++ {
+				s.Push([2]int{p, i})
+			}
+		}(p)
+	}
+	wg.Wait()
+	got := make([][][2]int, consumers)
+	for c := 0; c < consumers; c++ {
+		wg.Add(1)
+		go func(c int) {
+			defer wg.Done()
+			for e := s.Pop(); e != nil; e = s.Pop() {
+				got[c] = append(got[c], e.([2]int))
+			}
+		}(c)
+	}
+	wg.Wait()
+	seen := make([][]bool, producers)
+	for p := range seen {
+		seen[p] = make([]bool, per)
+	}
+	for c := range got {
+		last := make([]int, producers)
+		for p := range last {
+			last[p] = per
+		}
+		for _, e := range got[c] {
+			p, i := e[0], e[1]
+			if seen[p][i] {
+				t.Fatalf("element %v popped twice", e)
+			}
+			seen[p][i] = true
+			if i >= last[p] {
+				t.Fatalf("consumer %d: producer %d element %d after %d", c, p, i, last[p])
+			}
+			last[p] = i
+		}
+	}
+	for p := range seen {
+		for i, ok := range seen[p] {
+			if !ok {
+				t.Fatalf("element %v lost", [2]int{p, i})
+			}
+		}
+	}
+	if !s.Empty() || s.Size() != 0 {
+		t.Fatalf("stack not empty: size %d", s.Size())
+	}
+}
+
+func TestLockFreeConcurrentPushPop(t *testing.T) {
+	const workers, per = 8, 5000
+	s := NewLockFree()
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	seen := make(map[[2]int]bool)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < per; i++ {
+				s.Push([2]int{w, i})
+				e := s.Pop()
+				if e == nil {
+					t.Error("pop on a non-empty stack returned nil")
+					return
+				}
+				mu.Lock()
+				if seen[e.([2]int)] {
+					t.Errorf("element %v popped twice", e)
+				}
+				seen[e.([2]int)] = true
+				mu.Unlock()
+			}
+		}(w)
+	}
+	wg.Wait()
+	if len(seen) != workers*per || !s.Empty() {
+		t.Fatalf("popped %d of %d, empty %v", len(seen), workers*per, s.Empty())
+	}
+}
+
+func BenchmarkLockFree(b *testing.B) {
+	s := NewLockFree()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			s.Push(1)
+			s.Pop()
+		}
+	})
+}
+
+func BenchmarkMutex(b *testing.B) {
+	s := New()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			s.Push(1)
+			s.Pop()
+		}
+	})
+}
@@ -0,0 +1,314 @@
+package trie
+
+//@Title		trie
+//@Description
+//		AC自动机-Aho-Corasick
+//		以前缀树的节点为基础,为每个节点增加失配指针和输出指针
+//		可在一次扫描中找出文本中全部模式串的全部出现位置,时间复杂度为O(n+m+z),z为匹配数量
+//		支持对io.Reader进行流式匹配,状态在分块之间保持,故跨越分块边界的匹配同样能被找到
+//		插入模式串后需调用Build构建失配指针,若未构建则在查找时自动构建
+//		匹配以字节为单位进行
+import (
+	"github.com/hlccd/goSTL/data_structure/queue"
+	"io"
+	"sync"
+)
+
+//ahoCorasick自动机结构体
+//该实例存储前缀树的根节点以及每个存有元素的节点所对应的模式串
+//built记录当前失配指针是否与前缀树一致,插入新模式串后需重新构建
+type ahoCorasick struct {
+	root     *node            //根节点指针
+	patterns map[*node]string //存有元素的节点对应的模式串
+	built    bool             //是否已构建
+	mutex    sync.Mutex       //并发控制锁
+}
+
+//Match匹配结果结构体
+//包含匹配到的模式串、其在文本中的起止位置以及对应的元素
+//起止位置为左闭右开的字节下标,即text[Start:End]==Pattern
+type Match struct {
+	Pattern string      //匹配到的模式串
+	Start   int         //起始下标
+	End     int         //末尾下标的后一位
+	Value   interface{} //模式串对应的元素
+}
+
+//ahoCorasick自动机容器接口
+//存放了ahoCorasick自动机可使用的函数
+//对应函数介绍见下方
+type ahoCorasicker interface {
+	Size() (num int)                                           //返回模式串的数量
+	Clear()                                                    //清空全部模式串
+	Empty() (b bool)                                           //判断是否不含有模式串
+	Insert(pattern string, e interface{})                      //插入模式串及其对应的元素
+	Build()                                                    //构建失配指针和输出指针
+	FindAll(text string) (ms []Match)                          //返回文本中全部模式串的全部出现位置
+	FindReader(r io.Reader, fn func(m Match) bool) (err error) //对流中的文本进行匹配
+}
+
+//@title    NewAhoCorasick
+//@description
+//		新建一个ahoCorasick自动机并返回
+//		初始不含有任何模式串
+//@receiver		nil
+//@param    	nil
+//@return    	ac        	*ahoCorasick				新建的ahoCorasick指针
+func NewAhoCorasick() (ac *ahoCorasick) {
+	return &ahoCorasick{
+		root:     newNode(0, nil),
+		patterns: make(map[*node]string),
+		built:    false,
+		mutex:    sync.Mutex{},
+	}
+}
+
+//@title    Size
+//@description
+//		以ahoCorasick自动机做接收者
+//		返回不同模式串的数量
+//		如果容器为nil返回-1
+//@receiver		ac			*ahoCorasick			接受者ahoCorasick的指针
+//@param    	nil
+//@return    	num        	int						模式串的数量
+func (ac *ahoCorasick) Size() (num int) {
+	if ac == nil {
+		return -1
+	}
+	ac.mutex.Lock()
+	num = len(ac.patterns)
+	ac.mutex.Unlock()
+	return num
+}
+
+//@title    Clear
+//@description
+//		以ahoCorasick自动机做接收者
+//		清空全部模式串
+//@receiver		ac			*ahoCorasick			接受者ahoCorasick的指针
+//@param    	nil
+//@return    	nil
+func (ac *ahoCorasick) Clear() {
+	if ac == nil {
+		return
+	}
+	ac.mutex.Lock()
+	ac.root = newNode(0, nil)
+	ac.patterns = make(map[*node]string)
+	ac.built = false
+	ac.mutex.Unlock()
+}
+
+//@title    Empty
+//@description
+//		以ahoCorasick自动机做接收者
+//		判断是否不含有模式串
+//		如果容器不存在,返回true
+//@receiver		ac			*ahoCorasick			接受者ahoCorasick的指针
+//@param    	nil
+//@return    	b			bool					该容器是空的吗?
+func (ac *ahoCorasick) Empty() (b bool) {
+	return ac.Size() <= 0
+}
+
+//@title    Insert
+//@description
+//		以ahoCorasick自动机做接收者
+//		以前缀树的方式插入模式串,并在其最后一个字节对应的节点中存放元素e
+//		若e为nil则存放模式串本身,重复插入同一模式串时覆盖其元素
+//		空模式串不会被插入
+//		插入后需重新构建失配指针
+//@receiver		ac			*ahoCorasick			接受者ahoCorasick的指针
+//@param    	pattern		string					模式串
+//@param    	e			interface{}				模式串对应的元素
+//@return    	nil
+func (ac *ahoCorasick) Insert(pattern string, e interface{}) {
+	if ac == nil || pattern == "" {
+		return
+	}
+	ac.mutex.Lock()
+	ac.root.num++
+	now := ac.root
+	for i := 0; i < len(pattern); i++ {
+		now = now.nextOrNew(pattern[i])
+		now.num++
+	}
+	if e == nil {
+		now.value = pattern
+	} else {
+		now.value = e
+	}
+	ac.patterns[now] = pattern
+	ac.built = false
+	ac.mutex.Unlock()
+}
+
+//@title    Build
+//@description
+//		以ahoCorasick自动机做接收者
+//		构建失配指针和输出指针
+//@receiver		ac			*ahoCorasick			接受者ahoCorasick的指针
+//@param    	nil
+//@return    	nil
+func (ac *ahoCorasick) Build() {
+	if ac == nil {
+		return
+	}
+	ac.mutex.Lock()
+	ac.build()
+	ac.mutex.Unlock()
+}
+
+//@title    build
+//@description
+//		以ahoCorasick自动机做接收者
+//		从根节点开始按层进行广度优先遍历
+//		对于父节点u经字节c到达的节点v,沿u的失配指针寻找首个含有字节c子节点的节点f,v的失配指针即为f的该子节点
+//		若f的失配指针所指节点存有元素则输出指针指向它,否则继承其输出指针
+//		由于按层遍历,处理v时比其浅的节点均已处理完毕
+//@receiver		ac			*ahoCorasick			接受者ahoCorasick的指针
+//@param    	nil
+//@return    	nil
+func (ac *ahoCorasick) build() {
+	if ac.built {
+		return
+	}
+	q := queue.New()
+	ac.root.fail, ac.root.out = nil, nil
+	for _, v := range ac.root.son {
+		v.fail, v.out = ac.root, nil
+		q.Push(v)
+	}
+	for !q.Empty() {
+		u := q.Pop().(*node)
+		for _, v := range u.son {
+			f := u.fail
+			for f != ac.root && f.next(v.name) == nil {
+				f = f.fail
+			}
+			if m := f.next(v.name); m != nil {
+				v.fail = m
+			} else {
+				v.fail = ac.root
+			}
+			if v.fail.value != nil {
+				v.out = v.fail
+			} else {
+				v.out = v.fail.out
+			}
+			q.Push(v)
+		}
+	}
+	ac.built = true
+}
+
+//@title    step
+//@description
+//		以ahoCorasick自动机做接收者
+//		从状态now读入字节c后转移到新的状态
+//		当前状态不含有字节c的子节点时沿失配指针回退
+//@receiver		ac			*ahoCorasick			接受者ahoCorasick的指针
+//@param    	now			*node					当前状态
+//@param    	c			byte					读入的字节
+//@return    	m			*node					新的状态
+func (ac *ahoCorasick) step(now *node, c byte) (m *node) {
+	for now != ac.root && now.next(c) == nil {
+		now = now.fail
+	}
+	if m = now.next(c); m != nil {
+		return m
+	}
+	return ac.root
+}
+
+//@title    emit
+//@description
+//		以ahoCorasick自动机做接收者
+//		将以状态now结尾的全部模式串依次交给fn,end为当前已读入的字节数
+//		先给出最长的模式串,再沿输出指针依次给出更短的模式串
+//		当fn返回false时停止并返回false
+//@receiver		ac			*ahoCorasick			接受者ahoCorasick的指针
+//@param    	now			*node					当前状态
+//@param    	end			int						当前已读入的字节数
+//@param    	fn			func(m Match) bool		处理函数
+//@return    	b			bool					是否继续匹配
+func (ac *ahoCorasick) emit(now *node, end int, fn func(m Match) bool) (b bool) {
+	if now.value == nil {
+		now = now.out
+	}
+	for ; now != nil; now = now.out {
+		p := ac.patterns[now]
+		if !fn(Match{Pattern: p, Start: end - len(p), End: end, Value: now.value}) {
+			return false
+		}
+	}
+	return true
+}
+
+//@title    FindAll
+//@description
+//		以ahoCorasick自动机做接收者
+//		返回文本中全部模式串的全部出现位置,出现位置允许相互重叠
+//		结果按末尾位置升序排列,末尾位置相同时较长的模式串在前
+//		若尚未构建则先进行构建
+//@receiver		ac			*ahoCorasick			接受者ahoCorasick的指针
+//@param    	text		string					文本
+//@return    	ms			[]Match					全部匹配结果
+func (ac *ahoCorasick) FindAll(text string) (ms []Match) {
+	ms = make([]Match, 0, 0)
+	if ac == nil {
+		return ms
+	}
+	ac.mutex.Lock()
+	ac.build()
+	now := ac.root
+	for i := 0; i < len(text); i++ {
+		now = ac.step(now, text[i])
+		ac.emit(now, i+1, func(m Match) bool {
+			ms = append(ms, m)
+			return true
+		})
+	}
+	ac.mutex.Unlock()
+	return ms
+}
+
+//@title    FindReader
+//@description
+//		以ahoCorasick自动机做接收者
+//		从r中分块读取文本并进行匹配,每找到一个匹配即调用fn
+//		自动机状态在分块之间保持,故跨越分块边界的匹配同样会被找到
+//		匹配结果中的起止位置为在整个流中的字节下标
+//		当fn返回false时停止读取并返回nil
+//		读取出错时返回该错误,读取到io.EOF时视为正常结束并返回nil
+//		匹配期间持有锁,故fn中不可调用该自动机的函数
+//@receiver		ac			*ahoCorasick			接受者ahoCorasick的指针
+//@param    	r			io.Reader				待匹配的流
+//@param    	fn			func(m Match) bool		处理函数
+//@return    	err			error					读取时的错误
+func (ac *ahoCorasick) FindReader(r io.Reader, fn func(m Match) bool) (err error) {
+	if ac == nil {
+		return nil
+	}
+	ac.mutex.Lock()
+	defer ac.mutex.Unlock()
+	ac.build()
+	buf := make([]byte, 4096)
+	now, end := ac.root, 0
+	for {
+		n, rerr := r.Read(buf)
+		for i := 0; i < n; i++ {
+			now = ac.step(now, buf[i])
+			end++
+			if !ac.emit(now, end, fn) {
+				return nil
+			}
+		}
+		if rerr == io.EOF {
+			return nil
+		}
+		if rerr != nil {
+			return rerr
+		}
+	}
+}
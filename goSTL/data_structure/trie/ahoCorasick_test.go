@@ -0,0 +1,121 @@
+package trie
+
+import (
+	"errors"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+//naiveMatches对每个模式串逐位置比较,并按末尾升序、同末尾时较长者在前排列,作为参照
+func naiveMatches(text string, model map[string]interface{}) []Match {
+	ms := make([]Match, 0, 0)
+	for p, v := range model {
+		for i := 0; i+len(p) <= len(text); i++ {
+			if text[i:i+len(p)] == p {
+				ms = append(ms, Match{Pattern: p, Start: i, End: i + len(p), Value: v})
+			}
+		}
+	}
+	sort.Slice(ms, func(i, j int) bool {
+		if ms[i].End != ms[j].End {
+			return ms[i].End < ms[j].End
+		}
+		return ms[i].Start < ms[j].Start
+	})
+	return ms
+}
+
+func sameMatches(a, b []Match) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestAhoCorasickModel(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	for it := 0; it < 300; it++ {
+		ac := NewAhoCorasick()
+		model := map[string]interface{}{}
+		gen := func(n int) string {
+			b := make([]byte, n)
+			for i := range b {
+				b[i] = "abc"[r.Intn(3)]
+			}
+			return string(b)
+		}
+		for i := r.Intn(8); i >= 0; i-- {
+			p := gen(1 + r.Intn(4))
+			ac.Insert(p, i)
+			model[p] = i
+		}
+		//构建后再插入,查找时应自动重新构建
+		if r.Intn(2) == 0 {
+			ac.Build()
+			p := gen(1 + r.Intn(3))
+			ac.Insert(p, nil)
+			model[p] = p
+		}
+		if ac.Size() != len(model) {
+			t.Fatalf("Size = %d, want %d", ac.Size(), len(model))
+		}
+		text := gen(r.Intn(80))
+		want := naiveMatches(text, model)
+		if got := ac.FindAll(text); !sameMatches(got, want) {
+			t.Fatalf("FindAll(%q) = %v, want %v", text, got, want)
+		}
+		//逐字节读取,跨越分块边界的匹配也应找到
+		got := make([]Match, 0, 0)
+		err := ac.FindReader(iotest.OneByteReader(strings.NewReader(text)), func(m Match) bool {
+			got = append(got, m)
+			return true
+		})
+		if err != nil || !sameMatches(got, want) {
+			t.Fatalf("FindReader(%q) = %v, %v, want %v", text, got, err, want)
+		}
+	}
+}
+
+func TestAhoCorasickReader(t *testing.T) {
+	ac := NewAhoCorasick()
+	for _, p := range []string{"he", "she", "his", "hers"} {
+		ac.Insert(p, nil)
+	}
+	if ms := ac.FindAll("ushers"); len(ms) != 3 || ms[0].Pattern != "she" || ms[1].Pattern != "he" || ms[2].Pattern != "hers" {
+		t.Fatalf("FindAll(ushers) = %v", ms)
+	}
+	//超过一次读取的缓冲区大小
+	text := strings.Repeat("xushersx", 1000)
+	n := 0
+	if err := ac.FindReader(strings.NewReader(text), func(m Match) bool {
+		n++
+		return true
+	}); err != nil || n != 3000 {
+		t.Fatalf("FindReader found %d matches, err %v", n, err)
+	}
+	//fn返回false时停止
+	n = 0
+	if err := ac.FindReader(strings.NewReader(text), func(m Match) bool {
+		n++
+		return n < 5
+	}); err != nil || n != 5 {
+		t.Fatalf("FindReader did not stop: %d matches, err %v", n, err)
+	}
+	//读取出错时返回该错误
+	bad := errors.New("read failed")
+	if err := ac.FindReader(iotest.ErrReader(bad), func(m Match) bool { return true }); err != bad {
+		t.Fatalf("FindReader err = %v, want %v", err, bad)
+	}
+	ac.Clear()
+	if !ac.Empty() || len(ac.FindAll("ushers")) != 0 {
+		t.Fatal("Clear left patterns behind")
+	}
+}
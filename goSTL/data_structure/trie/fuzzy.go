@@ -0,0 +1,218 @@
+package trie
+
+//@Title		trie
+//@Description
+//		前缀树的模糊匹配和通配符匹配
+//		模糊匹配以编辑距离衡量key与待匹配字符串的差异
+//		从根节点向下遍历时,每一层节点仅根据父节点的动态规划行计算自身的一行
+//		共享前缀的key共享同一段计算,且当某一行的最小值已超过允许的编辑距离时直接剪枝
+//		通配符匹配支持'?'匹配任意单个字节,'*'匹配任意长度(包括0)的字节序列
+//		匹配以字节为单位进行
+
+//Result匹配结果结构体
+//包含匹配到的key、该key对应的元素以及与待匹配字符串的编辑距离
+//通配符匹配时编辑距离恒为0
+type Result struct {
+	Key      string      //匹配到的key
+	Value    interface{} //key对应的元素
+	Distance int         //编辑距离
+}
+
+//@title    FuzzySearch
+//@description
+//		以trie前缀树做接收者
+//		按字节升序返回与s的编辑距离不超过maxEdits的全部key及其元素和编辑距离
+//		编辑距离为Levenshtein距离,即插入、删除、替换单个字节的最少次数
+//		若传入damerau为true,则相邻两个字节的交换也计为一次编辑(OSA距离)
+//@receiver		t			*trie					接受者trie的指针
+//@param    	s			string					待匹配的字符串
+//@param    	maxEdits	int						允许的最大编辑距离
+//@param    	damerau		...bool					是否允许相邻交换
+//@return    	ms			[]Result				匹配结果
+func (t *trie) FuzzySearch(s string, maxEdits int, damerau ...bool) (ms []Result) {
+	ms = make([]Result, 0, 0)
+	if t == nil || maxEdits < 0 {
+		return ms
+	}
+	transpose := len(damerau) > 0 && damerau[0]
+	//根节点对应空串,与s[:j]的编辑距离为j
+	row := make([]int, len(s)+1)
+	for j := range row {
+		row[j] = j
+	}
+	t.mutex.Lock()
+	if t.root.value != nil && row[len(s)] <= maxEdits {
+		ms = append(ms, Result{Key: "", Value: t.root.value, Distance: row[len(s)]})
+	}
+	for i := 0; i < len(t.root.son); i++ {
+		ms = t.root.son[i].fuzzy(s, []byte{t.root.son[i].name}, nil, row, maxEdits, transpose, ms)
+	}
+	t.mutex.Unlock()
+	return ms
+}
+
+//@title    fuzzy
+//@description
+//		以node前缀树节点做接收者
+//		根据父节点的动态规划行pre计算该节点对应的行,cur[j]为key与s[:j]的编辑距离
+//		允许相邻交换时还需用到祖父节点的行prePre
+//		若该节点存有元素且cur[len(s)]不超过maxEdits则记入结果
+//		若该行最小值已超过maxEdits则其后代均不可能满足,直接剪枝
+//@receiver		n			*node					接受者node的指针
+//@param    	s			string					待匹配的字符串
+//@param    	key			[]byte					到达该节点的key
+//@param    	prePre		[]int					祖父节点的行
+//@param    	pre			[]int					父节点的行
+//@param    	maxEdits	int						允许的最大编辑距离
+//@param    	transpose	bool					是否允许相邻交换
+//@param    	ms			[]Result				已有的匹配结果
+//@return    	ans			[]Result				加入该节点及其后代的匹配结果
+func (n *node) fuzzy(s string, key []byte, prePre, pre []int, maxEdits int, transpose bool, ms []Result) (ans []Result) {
+	c := n.name
+	cur := make([]int, len(s)+1)
+	cur[0] = pre[0] + 1
+	minDist := cur[0]
+	for j := 1; j <= len(s); j++ {
+		cost := 1
+		if s[j-1] == c {
+			cost = 0
+		}
+		//删除、插入、替换三者取最小
+		cur[j] = pre[j] + 1
+		if cur[j-1]+1 < cur[j] {
+			cur[j] = cur[j-1] + 1
+		}
+		if pre[j-1]+cost < cur[j] {
+			cur[j] = pre[j-1] + cost
+		}
+		//相邻交换
+		if transpose && prePre != nil && j > 1 && s[j-1] == key[len(key)-2] && s[j-2] == c {
+			if prePre[j-2]+1 < cur[j] {
+				cur[j] = prePre[j-2] + 1
+			}
+		}
+		if cur[j] < minDist {
+			minDist = cur[j]
+		}
+	}
+	if n.value != nil && cur[len(s)] <= maxEdits {
+		ms = append(ms, Result{Key: string(key), Value: n.value, Distance: cur[len(s)]})
+	}
+	if minDist > maxEdits {
+		return ms
+	}
+	for i := 0; i < len(n.son); i++ {
+		ms = n.son[i].fuzzy(s, append(key, n.son[i].name), pre, cur, maxEdits, transpose, ms)
+	}
+	return ms
+}
+
+//@title    Match
+//@description
+//		以trie前缀树做接收者
+//		按字节升序返回与通配符模式pattern匹配的全部key及其元素
+//		'?'匹配任意单个字节,'*'匹配任意长度(包括0)的字节序列,其他字节需完全相同
+//@receiver		t			*trie					接受者trie的指针
+//@param    	pattern		string					通配符模式
+//@return    	ms			[]Result				匹配结果
+func (t *trie) Match(pattern string) (ms []Result) {
+	ms = make([]Result, 0, 0)
+	if t == nil {
+		return ms
+	}
+	t.mutex.Lock()
+	ms = t.root.match(pattern, 0, make([]byte, 0, 0), ms)
+	t.mutex.Unlock()
+	return ms
+}
+
+//@title    match
+//@description
+//		以node前缀树节点做接收者
+//		从该节点开始匹配pattern[p:],并将匹配到的key按字节升序记入结果
+//		先搜索出全部匹配成功的节点,再按序收集,避免同一个key因多种匹配方式被重复记入
+//@receiver		n			*node					接受者node的指针
+//@param    	pattern		string					通配符模式
+//@param    	p			int						当前匹配到的模式位置
+//@param    	key			[]byte					到达该节点的key
+//@param    	ms			[]Result				已有的匹配结果
+//@return    	ans			[]Result				加入该节点及其后代的匹配结果
+func (n *node) match(pattern string, p int, key []byte, ms []Result) (ans []Result) {
+	seen := make(map[*node]bool)
+	n.matchAt(pattern, p, seen, make(map[matchState]bool))
+	return n.collectMatched(key, seen, ms)
+}
+
+//matchState匹配状态结构体
+//记录某一节点与某一模式位置的组合是否已经搜索过,避免'*'导致的指数级重复搜索
+type matchState struct {
+	n *node //节点
+	p int   //模式位置
+}
+
+//@title    matchAt
+//@description
+//		以node前缀树节点做接收者
+//		从该节点和模式位置p开始进行搜索,将可以完整匹配的节点记入seen
+//		遇到'*'时先令其匹配空串,再令其吞下一个字节后继续匹配
+//		已经搜索过的节点与模式位置组合记入visited,不再重复搜索
+//@receiver		n			*node					接受者node的指针
+//@param    	pattern		string					通配符模式
+//@param    	p			int						当前匹配到的模式位置
+//@param    	seen		map[*node]bool			已匹配成功的节点
+//@param    	visited		map[matchState]bool		已搜索过的状态
+//@return    	nil
+func (n *node) matchAt(pattern string, p int, seen map[*node]bool, visited map[matchState]bool) {
+	st := matchState{n: n, p: p}
+	if visited[st] {
+		return
+	}
+	visited[st] = true
+	if p == len(pattern) {
+		if n.value != nil {
+			seen[n] = true
+		}
+		return
+	}
+	switch pattern[p] {
+	case '*':
+		//'*'匹配空串
+		n.matchAt(pattern, p+1, seen, visited)
+		//'*'吞下一个字节
+		for i := 0; i < len(n.son); i++ {
+			n.son[i].matchAt(pattern, p, seen, visited)
+		}
+	case '?':
+		for i := 0; i < len(n.son); i++ {
+			n.son[i].matchAt(pattern, p+1, seen, visited)
+		}
+	default:
+		if m := n.next(pattern[p]); m != nil {
+			m.matchAt(pattern, p+1, seen, visited)
+		}
+	}
+}
+
+//@title    collectMatched
+//@description
+//		以node前缀树节点做接收者
+//		按字节升序遍历以该节点为起点的子树,将seen中的节点记入结果
+//		仅遍历可能含有已匹配节点的部分
+//@receiver		n			*node					接受者node的指针
+//@param    	key			[]byte					到达该节点的key
+//@param    	seen		map[*node]bool			已匹配成功的节点
+//@param    	ms			[]Result				已有的匹配结果
+//@return    	ans			[]Result				加入匹配节点后的结果
+func (n *node) collectMatched(key []byte, seen map[*node]bool, ms []Result) (ans []Result) {
+	if len(seen) == 0 {
+		return ms
+	}
+	if seen[n] {
+		ms = append(ms, Result{Key: string(key), Value: n.value, Distance: 0})
+		delete(seen, n)
+	}
+	for i := 0; i < len(n.son) && len(seen) > 0; i++ {
+		ms = n.son[i].collectMatched(append(key, n.son[i].name), seen, ms)
+	}
+	return ms
+}
@@ -0,0 +1,155 @@
+package trie
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func least(a int, bs ...int) int {
+	for _, b := range bs {
+		if b < a {
+			a = b
+		}
+	}
+	return a
+}
+
+//osa求a与b的编辑距离,transpose为true时允许相邻交换,作为参照
+func osa(a, b string, transpose bool) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = least(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if transpose && i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = least(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(a)][len(b)]
+}
+
+//wildcard逐字节递归匹配,作为参照
+func wildcard(pattern, s string) bool {
+	if pattern == "" {
+		return s == ""
+	}
+	switch pattern[0] {
+	case '*':
+		return wildcard(pattern[1:], s) || s != "" && wildcard(pattern, s[1:])
+	case '?':
+		return s != "" && wildcard(pattern[1:], s[1:])
+	}
+	return s != "" && s[0] == pattern[0] && wildcard(pattern[1:], s[1:])
+}
+
+func TestFuzzyModel(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	tr := New()
+	model := map[string]interface{}{}
+	for i := 0; i < 200; i++ {
+		k := randKey(r, 6)
+		tr.Insert(k, i)
+		model[k] = i
+	}
+	keys := withPrefix(model, "")
+	for it := 0; it < 300; it++ {
+		s, maxEdits, transpose := randKey(r, 6), r.Intn(4), r.Intn(2) == 0
+		want := make([]Result, 0, 0)
+		for _, k := range keys {
+			if d := osa(k, s, transpose); d <= maxEdits {
+				want = append(want, Result{Key: k, Value: model[k], Distance: d})
+			}
+		}
+		got := tr.FuzzySearch(s, maxEdits, transpose)
+		if len(got) != len(want) {
+			t.Fatalf("FuzzySearch(%q, %d, %v) returned %d keys, want %d", s, maxEdits, transpose, len(got), len(want))
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				t.Fatalf("FuzzySearch(%q, %d, %v)[%d] = %v, want %v", s, maxEdits, transpose, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestMatchModel(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	tr := New()
+	model := map[string]interface{}{}
+	for i := 0; i < 200; i++ {
+		k := randKey(r, 6)
+		tr.Insert(k, i)
+		model[k] = i
+	}
+	keys := withPrefix(model, "")
+	const pool = "aA0?**"
+	for it := 0; it < 500; it++ {
+		b := make([]byte, r.Intn(6))
+		for i := range b {
+			b[i] = pool[r.Intn(len(pool))]
+		}
+		pattern := string(b)
+		want := make([]Result, 0, 0)
+		for _, k := range keys {
+			if wildcard(pattern, k) {
+				want = append(want, Result{Key: k, Value: model[k], Distance: 0})
+			}
+		}
+		got := tr.Match(pattern)
+		if len(got) != len(want) {
+			t.Fatalf("Match(%q) returned %d keys, want %d", pattern, len(got), len(want))
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				t.Fatalf("Match(%q)[%d] = %v, want %v", pattern, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestFuzzyCases(t *testing.T) {
+	tr := New()
+	for i, k := range []string{"kitten", "sitting", "mitten", "kitchen", "ktiten", "cat", "act"} {
+		tr.Insert(k, i)
+	}
+	tests := []struct {
+		name      string
+		s         string
+		maxEdits  int
+		transpose bool
+		keys      []string
+	}{
+		{"exact only", "kitten", 0, false, []string{"kitten"}},
+		{"one substitution", "kitten", 1, false, []string{"kitten", "mitten"}},
+		{"transposition counts as one", "kitten", 1, true, []string{"kitten", "ktiten", "mitten"}},
+		{"transposition counts as two", "cat", 2, false, []string{"act", "cat"}},
+		{"negative distance", "cat", -1, false, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make([]string, 0, 0)
+			for _, m := range tr.FuzzySearch(tt.s, tt.maxEdits, tt.transpose) {
+				got = append(got, m.Key)
+			}
+			if len(got) != len(tt.keys) {
+				t.Fatalf("FuzzySearch = %q, want %q", got, tt.keys)
+			}
+			for i := range got {
+				if got[i] != tt.keys[i] {
+					t.Fatalf("FuzzySearch = %q, want %q", got, tt.keys)
+				}
+			}
+		})
+	}
+}
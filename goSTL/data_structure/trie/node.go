@@ -1,38 +1,175 @@
 package trie
 
+//@Title		trie
+//@Description
+//		前缀树的节点
+//		每个节点对应key中的一个字节,子节点以稀疏切片的形式按字节升序存放
+//		可通过节点实现前缀树的添加、查找和删除
+//		也可通过节点返回以该节点为起点的全部key
+import "sort"
 
+//node树节点结构体
+//该节点是前缀树的树节点
+//num为以该节点为前缀的key的数量
+//子节点按name升序存放,查找时进行二分
+//value不为nil时说明有key恰好结束于该节点
+//fail和out仅在构建AC自动机后使用,普通前缀树中始终为nil
 type node struct {
-	num   int
-	son   [26]*node
-	value interface{}
+	name  byte        //该节点对应的字节
+	num   int         //以该节点为前缀的key的数量
+	son   []*node     //按字节升序存放的子节点
+	value interface{} //该节点中存储的元素
+	fail  *node       //失配指针,指向该节点对应字符串的最长真后缀所对应的节点
+	out   *node       //输出指针,沿失配指针能到达的最近的存有元素的节点
 }
 
-func newNode(e interface{}) (n *node) {
+//@title    newNode
+//@description
+//		新建一个前缀树节点并返回
+//		将传入的字节作为该节点对应的字节,传入的元素e作为该节点的承载元素
+//		初始时不含有子节点
+//@receiver		nil
+//@param    	name		byte					该节点对应的字节
+//@param    	e			interface{}				承载元素e
+//@return    	n        	*node					新建的前缀树节点的指针
+func newNode(name byte, e interface{}) (n *node) {
 	return &node{
+		name:  name,
 		num:   0,
+		son:   make([]*node, 0, 0),
 		value: e,
+		fail:  nil,
+		out:   nil,
 	}
 }
+
+//@title    inOrder
+//@description
+//		以node前缀树节点做接收者
+//		按字节升序返回以该节点为起点的全部key
+//		s为从根节点到该节点所经过的字节组成的前缀
+//@receiver		n			*node					接受者node的指针
+//@param    	s			string					到达该节点的前缀
+//@return    	es        	[]interface{}			以该节点为起点的全部key
 func (n *node) inOrder(s string) (es []interface{}) {
 	if n == nil {
 		return es
 	}
 	if n.value != nil {
-		es=append(es,s)
+		es = append(es, s)
 	}
-	for i := 0; i < 26; i++ {
-		if n.son[i] != nil {
-			es = append(es, n.son[i].inOrder(s + string(i+'a'))...)
-		}
+	for i := 0; i < len(n.son); i++ {
+		es = append(es, n.son[i].inOrder(s+string([]byte{n.son[i].name}))...)
 	}
-	//if n.value != nil {
-	//	fmt.Println(s)
-	//}else if b {
-	//	fmt.Println(s)
-	//}
-
-	//if n.right != nil {
-	//	es = append(es, n.right.inOrder()...)
-	//}
 	return es
 }
+
+//@title    search
+//@description
+//		以node前缀树节点做接收者
+//		二分查找对应字节为c的子节点应处的位置
+//		返回该位置以及该位置上的子节点是否恰好对应字节c
+//@receiver		n			*node					接受者node的指针
+//@param    	c			byte					待查找的字节
+//@return    	idx        	int						子节点应处的位置
+//@return    	ok        	bool					该子节点存在?
+func (n *node) search(c byte) (idx int, ok bool) {
+	idx = sort.Search(len(n.son), func(i int) bool {
+		return n.son[i].name >= c
+	})
+	return idx, idx < len(n.son) && n.son[idx].name == c
+}
+
+//@title    next
+//@description
+//		以node前缀树节点做接收者
+//		返回对应字节为c的子节点,不存在则返回nil
+//@receiver		n			*node					接受者node的指针
+//@param    	c			byte					待查找的字节
+//@return    	m        	*node					对应的子节点
+func (n *node) next(c byte) (m *node) {
+	if idx, ok := n.search(c); ok {
+		return n.son[idx]
+	}
+	return nil
+}
+
+//@title    nextOrNew
+//@description
+//		以node前缀树节点做接收者
+//		返回对应字节为c的子节点,不存在则新建该子节点并有序插入后返回
+//@receiver		n			*node					接受者node的指针
+//@param    	c			byte					待查找的字节
+//@return    	m        	*node					对应的子节点
+func (n *node) nextOrNew(c byte) (m *node) {
+	idx, ok := n.search(c)
+	if ok {
+		return n.son[idx]
+	}
+	m = newNode(c, nil)
+	n.son = append(n.son, nil)
+	copy(n.son[idx+1:], n.son[idx:])
+	n.son[idx] = m
+	return m
+}
+
+//@title    remove
+//@description
+//		以node前缀树节点做接收者
+//		删除对应字节为c的子节点
+//		子节点切片剩余空间过多时重新分配以释放空间
+//@receiver		n			*node					接受者node的指针
+//@param    	c			byte					待删除子节点的字节
+//@return    	nil
+func (n *node) remove(c byte) {
+	idx, ok := n.search(c)
+	if !ok {
+		return
+	}
+	copy(n.son[idx:], n.son[idx+1:])
+	n.son[len(n.son)-1] = nil
+	n.son = n.son[:len(n.son)-1]
+	if cap(n.son) > 4 && len(n.son)*2 <= cap(n.son) {
+		n.son = append(make([]*node, 0, len(n.son)), n.son...)
+	}
+}
+
+//@title    find
+//@description
+//		以node前缀树节点做接收者
+//		从该节点开始逐字节向下查找s对应的节点
+//		不存在则返回nil
+//@receiver		n			*node					接受者node的指针
+//@param    	s			string					待查找的字符串
+//@return    	m        	*node					s对应的节点
+func (n *node) find(s string) (m *node) {
+	m = n
+	for i := 0; i < len(s) && m != nil; i++ {
+		m = m.next(s[i])
+	}
+	return m
+}
+
+//@title    walk
+//@description
+//		以node前缀树节点做接收者
+//		按字节升序遍历以该节点为起点的全部key及其元素,并依次调用fn
+//		s为从根节点到该节点所经过的字节组成的前缀
+//		当fn返回false时停止遍历并返回false
+//@receiver		n			*node					接受者node的指针
+//@param    	s			[]byte									到达该节点的前缀
+//@param    	fn			func(key string, value interface{}) bool	遍历函数
+//@return    	b        	bool									是否遍历完毕
+func (n *node) walk(s []byte, fn func(key string, value interface{}) bool) (b bool) {
+	if n.value != nil {
+		if !fn(string(s), n.value) {
+			return false
+		}
+	}
+	for i := 0; i < len(n.son); i++ {
+		if !n.son[i].walk(append(s, n.son[i].name), fn) {
+			return false
+		}
+	}
+	return true
+}
@@ -1,32 +1,82 @@
 package trie
 
+//@Title		trie
+//@Description
+//		前缀树-Trie
+//		以多叉树的形式实现,从根节点到某一节点所经过的字节即为该节点对应的key
+//		key可以是任意字节序列,包括大小写字母、数字、符号以及UTF-8编码的多字节字符
+//		每个节点保存以其为前缀的key的数量,可快速统计某一前缀下的key的数量
+//		插入时若不传入元素,则以key本身作为存储的元素
+//		删除时将删除以传入字符串为前缀的全部key
+//
+//		内存占用:
+//		原实现中每个节点固定持有[26]*node数组,64位平台下仅子节点指针就占用208字节,且只能存放'a'-'z'
+//		现实现中子节点以按字节升序的稀疏切片存放,每个节点固定占用约72字节(字节、数量、切片头、元素、AC自动机所用的两个指针)
+//		每个子节点额外占用8字节指针,即节点内存与实际子节点数成正比
+//		前缀树通常十分稀疏(大部分节点只有一个子节点),故即便支持256种字节,总内存通常也小于原实现
+//		查找子节点时进行二分,最多比较8次,子节点较少时与数组下标访问相差不大
 import (
 	"github.com/hlccd/goSTL/utils/iterator"
 	"sync"
 )
 
+//trie前缀树结构体
+//该实例存储前缀树的根节点
+//根节点不对应任何字节,其num即为前缀树中存储的key的数量
 type trie struct {
 	root  *node      //根节点指针
 	mutex sync.Mutex //并发控制锁
 }
 
+//trie前缀树容器接口
+//存放了trie前缀树可使用的函数
+//对应函数介绍见下方
 type trieer interface {
-	Iterator() (i *iterator.Iterator) //返回包含该树堆的所有元素,重复则返回多个
-	Size() (num int)                  //返回该树堆中保存的元素个数
-	Clear()                           //清空该树堆
-	Empty() (b bool)                  //判断该树堆是否为空
-	Insert(s string, e interface{})   //向树堆中插入元素e
-	Erase(s string)                   //从树堆中删除元素e
-	Count(s string) (num int)         //从树堆中寻找元素e并返回其个数
-	Find(s string) (e interface{})
+	Iterator() (i *iterator.Iterator)                                                    //返回包含该前缀树的所有key
+	Size() (num int)                                                                     //返回该前缀树中保存的key的个数
+	Clear()                                                                              //清空该前缀树
+	Empty() (b bool)                                                                     //判断该前缀树是否为空
+	Insert(s string, e interface{})                                                      //向前缀树中插入key为s的元素e
+	Erase(s string)                                                                      //从前缀树中删除以s为前缀的全部key
+	Count(s string) (num int)                                                            //返回以s为前缀的key的个数
+	Find(s string) (e interface{})                                                       //返回key为s的元素
+	CountPrefix(p string) (num int)                                                      //返回以p为前缀的key的个数
+	KeysWithPrefix(p string) (ps []Pair)                                                 //返回以p为前缀的全部key及其元素
+	WalkPrefix(p string, fn func(key string, value interface{}) bool)                    //遍历以p为前缀的全部key及其元素
+	LongestPrefixOf(s string) (key string, value interface{}, ok bool)                   //返回是s的前缀的最长key及其元素
+	TopK(p string, k int, score func(key string, value interface{}) float64) (ps []Pair) //返回以p为前缀的得分最高的k个key及其元素
+	FuzzySearch(s string, maxEdits int, damerau ...bool) (ms []Result)                   //返回与s的编辑距离不超过maxEdits的全部key
+	Match(pattern string) (ms []Result)                                                  //返回与通配符模式匹配的全部key
 }
 
+//Pair键值对结构体
+//用于同时返回前缀树中的key及其对应的元素
+type Pair struct {
+	Key   string      //key
+	Value interface{} //key对应的元素
+}
+
+//@title    New
+//@description
+//		新建一个trie前缀树容器并返回
+//		初始根节点不对应任何字节且不承载元素
+//@receiver		nil
+//@param    	nil
+//@return    	t        	*trie						新建的trie指针
 func New() (t *trie) {
 	return &trie{
-		root:  newNode(nil),
+		root:  newNode(0, nil),
 		mutex: sync.Mutex{},
 	}
 }
+
+//@title    Iterator
+//@description
+//		以trie前缀树做接收者
+//		将该前缀树中所有保存的key按字节升序放入迭代器中
+//@receiver		t			*trie					接受者trie的指针
+//@param    	nil
+//@return    	i        	*iterator.Iterator		新建的Iterator迭代器指针
 func (t *trie) Iterator() (i *iterator.Iterator) {
 	if t == nil {
 		return iterator.New(make([]interface{}, 0, 0))
@@ -36,6 +86,15 @@ func (t *trie) Iterator() (i *iterator.Iterator) {
 	t.mutex.Unlock()
 	return i
 }
+
+//@title    Size
+//@description
+//		以trie前缀树做接收者
+//		返回该容器当前含有的key的数量
+//		如果容器为nil返回-1
+//@receiver		t			*trie					接受者trie的指针
+//@param    	nil
+//@return    	num        	int						容器中存储的key的数量
 func (t *trie) Size() (num int) {
 	if t == nil {
 		return -1
@@ -45,42 +104,85 @@ func (t *trie) Size() (num int) {
 	}
 	return t.root.num
 }
+
+//@title    Clear
+//@description
+//		以trie前缀树做接收者
+//		将该容器中所承载的元素清空
+//@receiver		t			*trie					接受者trie的指针
+//@param    	nil
+//@return    	nil
 func (t *trie) Clear() {
 	if t == nil {
 		return
 	}
 	t.mutex.Lock()
-	t.root = newNode(nil)
+	t.root = newNode(0, nil)
 	t.mutex.Unlock()
 }
+
+//@title    Empty
+//@description
+//		以trie前缀树做接收者
+//		判断该前缀树是否含有元素
+//		如果含有元素则不为空,返回false
+//		如果不含有元素则说明为空,返回true
+//		如果容器不存在,返回true
+//@receiver		t			*trie					接受者trie的指针
+//@param    	nil
+//@return    	b			bool					该容器是空的吗?
 func (t *trie) Empty() (b bool) {
 	if t.Size() > 0 {
 		return false
 	}
 	return true
 }
+
+//@title    Insert
+//@description
+//		以trie前缀树做接收者
+//		从根节点开始逐字节向下查找,不存在的节点进行新建,途经节点的num均加一
+//		在s的最后一个字节对应的节点中存放元素e,若e为nil则存放s本身
+//		若s已存在则只覆盖其元素,途经节点的num不变,以保证num与key的数量一致
+//@receiver		t			*trie					接受者trie的指针
+//@param    	s			string					待插入元素的key
+//@param    	e			interface{}				待插入元素
+//@return    	nil
 func (t *trie) Insert(s string, e interface{}) {
 	//判断容器是否存在
 	if t == nil {
 		return
 	}
+	if e == nil {
+		e = s
+	}
 	t.mutex.Lock()
+	//s已存在时只覆盖其元素
+	if now := t.root.find(s); now != nil && now.value != nil {
+		now.value = e
+		t.mutex.Unlock()
+		return
+	}
 	t.root.num++
 	now := t.root
 	for i := 0; i < len(s); i++ {
-		if now.son[s[i]-'a'] == nil {
-			now.son[s[i]-'a'] = newNode(nil)
-		}
-		now = now.son[s[i]-'a']
+		now = now.nextOrNew(s[i])
 		now.num++
 	}
-	if e == nil {
-		now.value = s
-	} else {
-		now.value = e
-	}
+	now.value = e
 	t.mutex.Unlock()
 }
+
+//@title    Erase
+//@description
+//		以trie前缀树做接收者
+//		删除以s为前缀的全部key
+//		先找到s对应的节点以确定待删除的key的数量,随后从根节点向下将途经节点的num减去该数量
+//		并将s对应的节点从其父节点中移除
+//		若s为空串则清空整个前缀树
+//@receiver		t			*trie					接受者trie的指针
+//@param    	s			string					待删除的前缀
+//@return    	nil
 func (t *trie) Erase(s string) {
 	if t == nil {
 		return
@@ -90,71 +192,191 @@ func (t *trie) Erase(s string) {
 	}
 	t.mutex.Lock()
 	if s == "" {
-		t.root = newNode(nil)
-	} else {
-		num := 0
-		for i, now := 0, t.root; i < len(s); i++ {
-			if now.son[s[i]-'a'] == nil {
-				num = 0
-				break
-			}
-			now = now.son[s[i]-'a']
-			num = now.num
-		}
-		if num > 0 {
-			for i, now := 0, t.root; i < len(s); i++ {
-				now.num -= num
-				if i == len(s)-1 {
-					for j := 0; j < 26; j++ {
-						now.son[j] = nil
-					}
-				} else {
-					now = now.son[s[i]-'a']
-				}
-			}
+		t.root = newNode(0, nil)
+		t.mutex.Unlock()
+		return
+	}
+	//查找s对应的节点以确定待删除的数量
+	now := t.root.find(s)
+	if now == nil || now.num <= 0 {
+		t.mutex.Unlock()
+		return
+	}
+	num := now.num
+	//从根节点向下减去待删除的数量,num归零的节点直接移除
+	now = t.root
+	for i := 0; i < len(s); i++ {
+		now.num -= num
+		m := now.next(s[i])
+		if m.num == num {
+			now.remove(s[i])
+			break
 		}
+		now = m
 	}
 	t.mutex.Unlock()
 }
+
+//@title    Count
+//@description
+//		以trie前缀树做接收者
+//		返回以s为前缀的key的数量
+//		若s为空串则返回全部key的数量
+//@receiver		t			*trie					接受者trie的指针
+//@param    	s			string					待查找的前缀
+//@return    	num			int						以s为前缀的key的数量
 func (t *trie) Count(s string) (num int) {
 	if t == nil {
 		return 0
 	}
 	if t.Empty() {
-		return
+		return 0
 	}
 	t.mutex.Lock()
-	now := t.root
-	for i := 0; i < len(s); i++ {
-		if now.son[s[i]-'a'] == nil {
-			num = 0
-			break
-		}
-		now = now.son[s[i]-'a']
+	if now := t.root.find(s); now != nil {
 		num = now.num
 	}
 	t.mutex.Unlock()
-	//树堆存在,从根节点开始查找该元素
 	return num
 }
+
+//@title    Find
+//@description
+//		以trie前缀树做接收者
+//		返回key为s的元素
+//		若不存在该key则返回nil
+//@receiver		t			*trie					接受者trie的指针
+//@param    	s			string					待查找元素的key
+//@return    	e			interface{}				key为s的元素
 func (t *trie) Find(s string) (e interface{}) {
 	if t == nil {
-		return 0
+		return nil
 	}
 	if t.Empty() {
+		return nil
+	}
+	t.mutex.Lock()
+	if now := t.root.find(s); now != nil {
+		e = now.value
+	}
+	t.mutex.Unlock()
+	return e
+}
+
+//@title    CountPrefix
+//@description
+//		以trie前缀树做接收者
+//		返回以p为前缀的key的数量
+//		由于每个节点都保存了以其为前缀的key的数量,故只需找到p对应的节点即可,时间复杂度为O(len(p))
+//@receiver		t			*trie					接受者trie的指针
+//@param    	p			string					待查找的前缀
+//@return    	num			int						以p为前缀的key的数量
+func (t *trie) CountPrefix(p string) (num int) {
+	return t.Count(p)
+}
+
+//@title    KeysWithPrefix
+//@description
+//		以trie前缀树做接收者
+//		按字节升序返回以p为前缀的全部key及其元素
+//		若p为空串则返回全部key及其元素
+//@receiver		t			*trie					接受者trie的指针
+//@param    	p			string					待查找的前缀
+//@return    	ps			[]Pair					以p为前缀的全部key及其元素
+func (t *trie) KeysWithPrefix(p string) (ps []Pair) {
+	ps = make([]Pair, 0, 0)
+	t.WalkPrefix(p, func(key string, value interface{}) bool {
+		ps = append(ps, Pair{Key: key, Value: value})
+		return true
+	})
+	return ps
+}
+
+//@title    WalkPrefix
+//@description
+//		以trie前缀树做接收者
+//		按字节升序遍历以p为前缀的全部key及其元素,并依次调用fn
+//		当fn返回false时停止遍历
+//		遍历期间持有该前缀树的锁,故fn中不可调用该前缀树的函数
+//@receiver		t			*trie									接受者trie的指针
+//@param    	p			string									待遍历的前缀
+//@param    	fn			func(key string, value interface{}) bool	遍历函数
+//@return    	nil
+func (t *trie) WalkPrefix(p string, fn func(key string, value interface{}) bool) {
+	if t == nil {
 		return
 	}
 	t.mutex.Lock()
+	if now := t.root.find(p); now != nil {
+		now.walk([]byte(p), fn)
+	}
+	t.mutex.Unlock()
+}
+
+//@title    LongestPrefixOf
+//@description
+//		以trie前缀树做接收者
+//		在前缀树中查找是s的前缀的最长key,并返回该key及其元素
+//		key可以等于s本身,若不存在这样的key则ok返回false
+//@receiver		t			*trie					接受者trie的指针
+//@param    	s			string					待匹配的字符串
+//@return    	key			string					最长的前缀key
+//@return    	value		interface{}				该key对应的元素
+//@return    	ok			bool					存在这样的key?
+func (t *trie) LongestPrefixOf(s string) (key string, value interface{}, ok bool) {
+	if t == nil {
+		return "", nil, false
+	}
+	t.mutex.Lock()
 	now := t.root
-	for i := 0; i < len(s); i++ {
-		if now.son[s[i]-'a'] == nil {
-			e = nil
+	for i := 0; now != nil; i++ {
+		if now.value != nil {
+			key, value, ok = s[:i], now.value, true
+		}
+		if i == len(s) {
 			break
 		}
-		now = now.son[s[i]-'a']
-		e = now.value
+		now = now.next(s[i])
 	}
 	t.mutex.Unlock()
-	//树堆存在,从根节点开始查找该元素
-	return e
+	return key, value, ok
+}
+
+//@title    TopK
+//@description
+//		以trie前缀树做接收者
+//		在以p为前缀的全部key中按score给出的得分从高到低返回前k个key及其元素
+//		得分相同时key按字节升序排列
+//		遍历时仅维护当前得分最高的k个key,时间复杂度为O(m*k),m为以p为前缀的key的数量
+//@receiver		t			*trie										接受者trie的指针
+//@param    	p			string										待查找的前缀
+//@param    	k			int											返回的数量
+//@param    	score		func(key string, value interface{}) float64	得分函数
+//@return    	ps			[]Pair										得分最高的k个key及其元素
+func (t *trie) TopK(p string, k int, score func(key string, value interface{}) float64) (ps []Pair) {
+	ps = make([]Pair, 0, 0)
+	if k <= 0 {
+		return ps
+	}
+	scores := make([]float64, 0, k)
+	t.WalkPrefix(p, func(key string, value interface{}) bool {
+		sc := score(key, value)
+		if len(ps) == k && sc <= scores[k-1] {
+			return true
+		}
+		//寻找插入位置,得分相同时排在已有元素之后
+		idx := len(ps)
+		for idx > 0 && scores[idx-1] < sc {
+			idx--
+		}
+		if len(ps) < k {
+			ps = append(ps, Pair{})
+			scores = append(scores, 0)
+		}
+		copy(ps[idx+1:], ps[idx:])
+		copy(scores[idx+1:], scores[idx:])
+		ps[idx], scores[idx] = Pair{Key: key, Value: value}, sc
+		return true
+	})
+	return ps
 }
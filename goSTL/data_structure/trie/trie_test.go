@@ -0,0 +1,193 @@
+package trie
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+//字母表包含大小写、数字、符号、0x00、0xff以及多字节字符的各个字节
+const alphabet = "aA0-\x00\xff\xc3\xa9"
+
+func randKey(r *rand.Rand, n int) string {
+	var b strings.Builder
+	for i := r.Intn(n + 1); i > 0; i-- {
+		b.WriteByte(alphabet[r.Intn(len(alphabet))])
+	}
+	return b.String()
+}
+
+//以map作为参照,统计以p为前缀的key
+func withPrefix(model map[string]interface{}, p string) []string {
+	ks := make([]string, 0, 0)
+	for k := range model {
+		if strings.HasPrefix(k, p) {
+			ks = append(ks, k)
+		}
+	}
+	sort.Strings(ks)
+	return ks
+}
+
+func TestTrieModel(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	tr := New()
+	model := map[string]interface{}{}
+	for it := 0; it < 5000; it++ {
+		k := randKey(r, 4)
+		switch r.Intn(5) {
+		case 0, 1, 2:
+			var e interface{}
+			if r.Intn(2) == 0 {
+				e = it
+			}
+			tr.Insert(k, e)
+			if e == nil {
+				e = k
+			}
+			model[k] = e
+		case 3:
+			if r.Intn(10) > 0 && k == "" {
+				continue
+			}
+			tr.Erase(k)
+			for _, d := range withPrefix(model, k) {
+				delete(model, d)
+			}
+		case 4:
+			if got, want := tr.Count(k), len(withPrefix(model, k)); got != want {
+				t.Fatalf("Count(%q) = %d, want %d", k, got, want)
+			}
+		}
+		if tr.Size() != len(model) {
+			t.Fatalf("Size = %d, want %d", tr.Size(), len(model))
+		}
+		if got, want := tr.Find(k), model[k]; got != want {
+			t.Fatalf("Find(%q) = %v, want %v", k, got, want)
+		}
+	}
+	want := withPrefix(model, "")
+	got := make([]string, 0, 0)
+	for i := tr.Iterator(); i.HasNext(); i.Next() {
+		got = append(got, i.Value().(string))
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Iterator = %q, want %q", got, want)
+	}
+}
+
+func TestTrieBytes(t *testing.T) {
+	keys := []string{"Hello", "hello", "héllo", "user-42", "user-7", "\x00", "\xff", ""}
+	tr := New()
+	for _, k := range keys {
+		tr.Insert(k, nil)
+	}
+	if tr.Size() != len(keys) {
+		t.Fatalf("Size = %d, want %d", tr.Size(), len(keys))
+	}
+	for _, k := range keys {
+		if tr.Find(k) != k {
+			t.Errorf("Find(%q) = %v", k, tr.Find(k))
+		}
+	}
+	if tr.Find("h\xc3") != nil || tr.Count("h\xc3") != 1 {
+		t.Errorf("partial UTF-8 prefix: Find = %v, Count = %d", tr.Find("h\xc3"), tr.Count("h\xc3"))
+	}
+	tr.Erase("user")
+	if tr.Size() != len(keys)-2 || tr.Find("user-7") != nil {
+		t.Errorf("Erase(user): Size = %d", tr.Size())
+	}
+	tr.Erase("")
+	if !tr.Empty() {
+		t.Errorf("Erase of the empty prefix left %d keys", tr.Size())
+	}
+}
+
+func TestPrefixModel(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	tr := New()
+	model := map[string]interface{}{}
+	for i := 0; i < 300; i++ {
+		k := randKey(r, 5)
+		//重复插入同一key不应改变前缀计数
+		tr.Insert(k, i)
+		model[k] = i
+	}
+	score := func(key string, value interface{}) float64 {
+		return float64(value.(int) % 7)
+	}
+	for it := 0; it < 500; it++ {
+		p := randKey(r, 3)
+		ks := withPrefix(model, p)
+		if got := tr.CountPrefix(p); got != len(ks) {
+			t.Fatalf("CountPrefix(%q) = %d, want %d", p, got, len(ks))
+		}
+		ps := tr.KeysWithPrefix(p)
+		if len(ps) != len(ks) {
+			t.Fatalf("KeysWithPrefix(%q) returned %d keys, want %d", p, len(ps), len(ks))
+		}
+		for i := range ps {
+			if ps[i].Key != ks[i] || ps[i].Value != model[ks[i]] {
+				t.Fatalf("KeysWithPrefix(%q)[%d] = %v, want {%q %v}", p, i, ps[i], ks[i], model[ks[i]])
+			}
+		}
+		//遍历在fn返回false时停止
+		n, stop := 0, r.Intn(4)
+		tr.WalkPrefix(p, func(key string, value interface{}) bool {
+			n++
+			return n <= stop
+		})
+		if want := stop + 1; want > len(ks) && n != len(ks) || want <= len(ks) && n != want {
+			t.Fatalf("WalkPrefix(%q) visited %d keys, stop after %d of %d", p, n, stop, len(ks))
+		}
+		//TopK参照:按得分降序稳定排序后取前k个
+		k := r.Intn(6)
+		want := append(make([]string, 0, len(ks)), ks...)
+		sort.SliceStable(want, func(i, j int) bool {
+			return score(want[i], model[want[i]]) > score(want[j], model[want[j]])
+		})
+		if len(want) > k {
+			want = want[:k]
+		}
+		top := tr.TopK(p, k, score)
+		got := make([]string, 0, 0)
+		for _, pr := range top {
+			got = append(got, pr.Key)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("TopK(%q, %d) = %q, want %q", p, k, got, want)
+		}
+		//LongestPrefixOf参照:是s的前缀的最长key
+		s := randKey(r, 7)
+		wk, wok := "", false
+		for i := len(s); i >= 0; i-- {
+			if _, ok := model[s[:i]]; ok {
+				wk, wok = s[:i], true
+				break
+			}
+		}
+		if key, value, ok := tr.LongestPrefixOf(s); key != wk || ok != wok || ok && value != model[wk] {
+			t.Fatalf("LongestPrefixOf(%q) = %q, %v, %v, want %q, %v", s, key, value, ok, wk, wok)
+		}
+	}
+}
+
+func TestReinsertKeepsCounts(t *testing.T) {
+	tr := New()
+	for _, k := range []string{"ab", "ab", "abc", "a", "ab", "", ""} {
+		tr.Insert(k, nil)
+	}
+	if tr.Size() != 4 || tr.CountPrefix("a") != 3 || tr.CountPrefix("ab") != 2 || len(tr.KeysWithPrefix("ab")) != 2 {
+		t.Fatalf("Size = %d, CountPrefix(a) = %d, CountPrefix(ab) = %d", tr.Size(), tr.CountPrefix("a"), tr.CountPrefix("ab"))
+	}
+	tr.Insert("ab", 5)
+	if tr.Find("ab") != 5 || tr.Size() != 4 {
+		t.Fatalf("overwrite: Find(ab) = %v, Size = %d", tr.Find("ab"), tr.Size())
+	}
+	tr.Erase("ab")
+	if tr.Size() != 2 || tr.CountPrefix("a") != 1 {
+		t.Fatalf("Erase(ab): Size = %d, CountPrefix(a) = %d", tr.Size(), tr.CountPrefix("a"))
+	}
+}